@@ -0,0 +1,60 @@
+package esi
+
+import (
+	"fmt"
+	"time"
+)
+
+// Alliance is the public information of an alliance
+type Alliance struct {
+	CreatorCorporationID  uint32    `json:"creator_corporation_id"`
+	CreatorID             uint32    `json:"creator_id"`
+	DateFounded           time.Time `json:"date_founded"`
+	ExecutorCorporationID uint32    `json:"executor_corporation_id,omitempty"`
+	FactionID             uint32    `json:"faction_id,omitempty"`
+	Name                  string    `json:"name"`
+	Ticker                string    `json:"ticker"`
+}
+
+// AllianceIcons is the urls of the alliances logo in each size
+type AllianceIcons struct {
+	Px64x64   string `json:"px64x64,omitempty"`
+	Px128x128 string `json:"px128x128,omitempty"`
+}
+
+// IsClosed gets if the alliance has no executor, which happens once every corporation has left
+func (alliance Alliance) IsClosed() bool {
+	return alliance.ExecutorCorporationID == 0
+}
+
+// GetAlliances gets the ids of every active alliance
+func (esi Client) GetAlliances() ([]uint32, error) {
+	return esi.getIds("/v2/alliances/")
+}
+
+// GetAlliance gets the public information of an alliance
+func (esi Client) GetAlliance(allianceID uint32) (Alliance, error) {
+	var alliance Alliance
+	err := esi.get(fmt.Sprintf("/v4/alliances/%d/", allianceID), &alliance)
+	if err != nil {
+		return Alliance{}, err
+	}
+
+	return alliance, nil
+}
+
+// GetAllianceCorporations gets the ids of every corporation in the alliance
+func (esi Client) GetAllianceCorporations(allianceID uint32) ([]uint32, error) {
+	return esi.getIds(fmt.Sprintf("/v2/alliances/%d/corporations/", allianceID))
+}
+
+// GetAllianceIcons gets the urls of the alliances logo
+func (esi Client) GetAllianceIcons(allianceID uint32) (AllianceIcons, error) {
+	var icons AllianceIcons
+	err := esi.get(fmt.Sprintf("/v2/alliances/%d/icons/", allianceID), &icons)
+	if err != nil {
+		return AllianceIcons{}, err
+	}
+
+	return icons, nil
+}
@@ -0,0 +1,230 @@
+package esi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Asset is a single item owned by a character
+type Asset struct {
+	IsBlueprintCopy bool   `json:"is_blueprint_copy,omitempty"`
+	IsSingleton     bool   `json:"is_singleton"`
+	ItemID          uint64 `json:"item_id"`
+	LocationFlag    string `json:"location_flag"`
+	LocationID      uint64 `json:"location_id"`
+	LocationType    string `json:"location_type"`
+	Quantity        int32  `json:"quantity"`
+	TypeID          uint32 `json:"type_id"`
+}
+
+// AssetName is the player given name of an assembled ship or container
+type AssetName struct {
+	ItemID uint64 `json:"item_id"`
+	Name   string `json:"name"`
+}
+
+// AssetLocation is the position in space of an asset
+type AssetLocation struct {
+	ItemID   uint64   `json:"item_id"`
+	Position Position `json:"position"`
+}
+
+// AssetNode is an asset along with everything that is stored inside of it
+type AssetNode struct {
+	Asset
+	Name     string
+	Contents []*AssetNode
+}
+
+// AssetRoot is a top level location (station, structure or solar system) that holds assets
+type AssetRoot struct {
+	LocationID   uint64
+	LocationType string
+	Name         string
+	SystemID     uint32
+	Items        []*AssetNode
+}
+
+// asset names and locations can only be requested in batches of this size
+const assetBatchSize = 1000
+
+// GetCharacterAssets gets every asset the character owns across all pages
+func (esi Client) GetCharacterAssets(characterID uint32, token string) ([]Asset, error) {
+	return getAllPages[Asset](esi, fmt.Sprintf("/v5/characters/%d/assets/", characterID), token)
+}
+
+// GetCharacterAssetNames gets the names of the specified assembled ships and containers
+func (esi Client) GetCharacterAssetNames(characterID uint32, itemIDs []uint64, token string) ([]AssetName, error) {
+	var names []AssetName
+
+	for start := 0; start < len(itemIDs); start += assetBatchSize {
+		end := min(start+assetBatchSize, len(itemIDs))
+		buffer, err := json.Marshal(itemIDs[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		var batch []AssetName
+		err = esi.authPostRead(fmt.Sprintf("/v1/characters/%d/assets/names/", characterID), token, buffer, &batch)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, batch...)
+	}
+
+	return names, nil
+}
+
+// GetCharacterAssetLocations gets the positions of the specified assets
+func (esi Client) GetCharacterAssetLocations(characterID uint32, itemIDs []uint64, token string) ([]AssetLocation, error) {
+	var locations []AssetLocation
+
+	for start := 0; start < len(itemIDs); start += assetBatchSize {
+		end := min(start+assetBatchSize, len(itemIDs))
+		buffer, err := json.Marshal(itemIDs[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		var batch []AssetLocation
+		err = esi.authPostRead(fmt.Sprintf("/v2/characters/%d/assets/locations/", characterID), token, buffer, &batch)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, batch...)
+	}
+
+	return locations, nil
+}
+
+// GetCharacterAssetTree gets all the characters assets and nests them by location (station/structure -> ship -> container -> item)
+func (esi Client) GetCharacterAssetTree(characterID uint32, token string) ([]*AssetRoot, error) {
+	assets, err := esi.GetCharacterAssets(characterID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := BuildAssetTree(assets)
+
+	var singletons []uint64
+	for _, asset := range assets {
+		if asset.IsSingleton {
+			singletons = append(singletons, asset.ItemID)
+		}
+	}
+
+	names, err := esi.GetCharacterAssetNames(characterID, singletons, token)
+	if err != nil {
+		return nil, err
+	}
+
+	named := map[uint64]string{}
+	for _, name := range names {
+		if name.Name != "None" {
+			named[name.ItemID] = name.Name
+		}
+	}
+
+	for _, root := range roots {
+		applyAssetNames(root.Items, named)
+
+		if err := esi.resolveAssetRoot(root, token); err != nil {
+			return nil, err
+		}
+	}
+
+	return roots, nil
+}
+
+// BuildAssetTree nests a flat list of assets using their location ids, returning the top level locations
+func BuildAssetTree(assets []Asset) []*AssetRoot {
+	nodes := map[uint64]*AssetNode{}
+	for _, asset := range assets {
+		nodes[asset.ItemID] = &AssetNode{Asset: asset}
+	}
+
+	roots := map[uint64]*AssetRoot{}
+	for _, asset := range assets {
+		node := nodes[asset.ItemID]
+
+		if parent, ok := nodes[asset.LocationID]; ok {
+			parent.Contents = append(parent.Contents, node)
+			continue
+		}
+
+		root, ok := roots[asset.LocationID]
+		if !ok {
+			root = &AssetRoot{
+				LocationID:   asset.LocationID,
+				LocationType: rootLocationType(asset),
+			}
+			roots[asset.LocationID] = root
+		}
+
+		root.Items = append(root.Items, node)
+	}
+
+	tree := make([]*AssetRoot, 0, len(roots))
+	for _, root := range roots {
+		tree = append(tree, root)
+	}
+
+	sort.Slice(tree, func(i, j int) bool {
+		return tree[i].LocationID < tree[j].LocationID
+	})
+
+	return tree
+}
+
+func rootLocationType(asset Asset) string {
+	switch {
+	case asset.LocationType == "station" || (asset.LocationID >= 60000000 && asset.LocationID < 64000000):
+		return "station"
+	case asset.LocationType == "solar_system" || (asset.LocationID >= 30000000 && asset.LocationID < 33000000):
+		return "solar_system"
+	case asset.LocationID >= 1000000000000:
+		return "structure"
+	}
+
+	return "other"
+}
+
+func applyAssetNames(nodes []*AssetNode, names map[uint64]string) {
+	for _, node := range nodes {
+		node.Name = names[node.ItemID]
+		applyAssetNames(node.Contents, names)
+	}
+}
+
+func (esi Client) resolveAssetRoot(root *AssetRoot, token string) error {
+	switch root.LocationType {
+	case "station":
+		station, err := esi.GetStation(uint32(root.LocationID))
+		if err != nil {
+			return err
+		}
+
+		root.Name = station.Name
+		root.SystemID = station.SystemID
+	case "solar_system":
+		system, err := esi.GetSystem(uint32(root.LocationID))
+		if err != nil {
+			return err
+		}
+
+		root.Name = system.Name
+		root.SystemID = system.ID
+	case "structure":
+		// Losing docking access to a structure is common, so leave those unnamed instead of failing the tree
+		structure, err := esi.GetStructure(root.LocationID, token)
+		if err == nil {
+			root.Name = structure.Name
+			root.SystemID = structure.SolarSystemID
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package esi
+
+import (
+	"fmt"
+)
+
+// Blueprint is a blueprint original, copy or stack of originals owned by a character
+type Blueprint struct {
+	ItemID             int64  `json:"item_id"`
+	LocationFlag       string `json:"location_flag"`
+	LocationID         int64  `json:"location_id"`
+	MaterialEfficiency int32  `json:"material_efficiency"`
+	Quantity           int32  `json:"quantity"`
+	Runs               int32  `json:"runs"`
+	TimeEfficiency     int32  `json:"time_efficiency"`
+	TypeID             uint32 `json:"type_id"`
+}
+
+// GetCharacterBlueprints gets every page of the characters blueprints
+func (esi Client) GetCharacterBlueprints(characterID uint32, token string) ([]Blueprint, error) {
+	return getAllPages[Blueprint](esi, fmt.Sprintf("/v3/characters/%d/blueprints/", characterID), token)
+}
+
+// IsCopy checks if the blueprint is a copy (esi reports copies with a quantity of -2)
+func (blueprint Blueprint) IsCopy() bool {
+	return blueprint.Quantity == -2
+}
+
+// IsOriginal checks if the blueprint is an original, either a single item or a stack
+func (blueprint Blueprint) IsOriginal() bool {
+	return blueprint.Quantity == -1 || blueprint.Quantity > 0
+}
+
+// Count gets how many blueprints this entry represents, stacks of originals report their size as the quantity
+func (blueprint Blueprint) Count() int32 {
+	if blueprint.Quantity > 0 {
+		return blueprint.Quantity
+	}
+
+	return 1
+}
+
+// RemainingRuns gets the runs left on a copy, originals have unlimited runs and return -1
+func (blueprint Blueprint) RemainingRuns() int32 {
+	if !blueprint.IsCopy() {
+		return -1
+	}
+
+	return blueprint.Runs
+}
@@ -0,0 +1,170 @@
+package esi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CalendarSummary is the summary of an event on the characters calendar
+type CalendarSummary struct {
+	EventDate     time.Time `json:"event_date"`
+	EventID       int32     `json:"event_id"`
+	EventResponse string    `json:"event_response"`
+	Importance    int32     `json:"importance"`
+	Title         string    `json:"title"`
+}
+
+// CalendarEvent is the full details of a calendar event
+type CalendarEvent struct {
+	Date       time.Time `json:"date"`
+	Duration   int32     `json:"duration"`
+	EventID    int32     `json:"event_id"`
+	Importance int32     `json:"importance"`
+	OwnerID    int32     `json:"owner_id"`
+	OwnerName  string    `json:"owner_name"`
+	OwnerType  string    `json:"owner_type"`
+	Response   string    `json:"response"`
+	Text       string    `json:"text"`
+	Title      string    `json:"title"`
+}
+
+// EventAttendee is a character that has responded to a calendar event
+type EventAttendee struct {
+	CharacterID   uint32 `json:"character_id"`
+	EventResponse string `json:"event_response"`
+}
+
+type eventResponse struct {
+	Response string `json:"response"`
+}
+
+// GetCharacterCalendar gets the next 50 events on the calendar, starting after fromEvent (0 for the next upcoming event)
+func (esi Client) GetCharacterCalendar(characterID uint32, fromEvent int32, token string) ([]CalendarSummary, error) {
+	path := fmt.Sprintf("/v2/characters/%d/calendar/", characterID)
+	if fromEvent > 0 {
+		path = fmt.Sprintf("%s?from_event=%d", path, fromEvent)
+	}
+
+	var events []CalendarSummary
+	err := esi.authGet(path, token, &events)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// GetCalendarEvent gets the full details of a calendar event
+func (esi Client) GetCalendarEvent(characterID uint32, eventID int32, token string) (*CalendarEvent, error) {
+	var event CalendarEvent
+	err := esi.authGet(fmt.Sprintf("/v4/characters/%d/calendar/%d/", characterID, eventID), token, &event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// GetCalendarEventAttendees gets everyone that has responded to a calendar event
+func (esi Client) GetCalendarEventAttendees(characterID uint32, eventID int32, token string) ([]EventAttendee, error) {
+	var attendees []EventAttendee
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/calendar/%d/attendees/", characterID, eventID), token, &attendees)
+	if err != nil {
+		return nil, err
+	}
+
+	return attendees, nil
+}
+
+// RespondToCalendarEvent sets the characters response to an event (accepted, declined or tentative)
+func (esi Client) RespondToCalendarEvent(characterID uint32, eventID int32, response string, token string) error {
+	buffer, err := json.Marshal(eventResponse{Response: response})
+	if err != nil {
+		return err
+	}
+
+	return esi.authPut(fmt.Sprintf("/v4/characters/%d/calendar/%d/", characterID, eventID), token, buffer, nil)
+}
+
+// ToICalendar converts calendar events into an RFC 5545 iCalendar document
+func ToICalendar(name string, events []CalendarEvent) string {
+	var builder strings.Builder
+	stamp := formatICalendarTime(time.Now())
+
+	writeICalendarLine(&builder, "BEGIN:VCALENDAR")
+	writeICalendarLine(&builder, "VERSION:2.0")
+	writeICalendarLine(&builder, "PRODID:-//w9jds//go.esi//EN")
+	writeICalendarLine(&builder, "CALSCALE:GREGORIAN")
+	if name != "" {
+		writeICalendarLine(&builder, "X-WR-CALNAME:"+escapeICalendarText(name))
+	}
+
+	for _, event := range events {
+		duration := time.Duration(event.Duration) * time.Minute
+
+		writeICalendarLine(&builder, "BEGIN:VEVENT")
+		writeICalendarLine(&builder, fmt.Sprintf("UID:%d@calendar.eveonline.com", event.EventID))
+		writeICalendarLine(&builder, "DTSTAMP:"+stamp)
+		writeICalendarLine(&builder, "DTSTART:"+formatICalendarTime(event.Date))
+		writeICalendarLine(&builder, "DTEND:"+formatICalendarTime(event.Date.Add(duration)))
+		writeICalendarLine(&builder, "SUMMARY:"+escapeICalendarText(event.Title))
+		if event.Text != "" {
+			writeICalendarLine(&builder, "DESCRIPTION:"+escapeICalendarText(MailBodyToText(event.Text)))
+		}
+		if event.OwnerName != "" {
+			writeICalendarLine(&builder, "ORGANIZER;CN="+escapeICalendarParam(event.OwnerName)+":mailto:noreply@eveonline.com")
+		}
+		if event.Importance > 0 {
+			writeICalendarLine(&builder, "PRIORITY:1")
+		}
+		writeICalendarLine(&builder, "END:VEVENT")
+	}
+
+	writeICalendarLine(&builder, "END:VCALENDAR")
+	return builder.String()
+}
+
+func formatICalendarTime(value time.Time) string {
+	return value.UTC().Format("20060102T150405Z")
+}
+
+func escapeICalendarText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+
+	return replacer.Replace(value)
+}
+
+func escapeICalendarParam(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, "'") + `"`
+}
+
+// writeICalendarLine folds content lines longer than 75 octets as required by RFC 5545
+func writeICalendarLine(builder *strings.Builder, line string) {
+	limit := 75
+
+	for len(line) > limit {
+		cut := limit
+		// Don't split a multi-byte utf-8 character across lines
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+
+		builder.WriteString(line[:cut])
+		builder.WriteString("\r\n ")
+		line = line[cut:]
+
+		// continuation lines start with a space which counts towards the limit
+		limit = 74
+	}
+
+	builder.WriteString(line)
+	builder.WriteString("\r\n")
+}
@@ -3,6 +3,7 @@ package esi
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // CharacterDetails references information from the character endpoint
@@ -72,6 +73,49 @@ type CorporationHistory struct {
 	StartDate string `json:"start_date,omitempty"`
 }
 
+// LoyaltyPoints is the amount of loyalty points the character has with a corporation
+type LoyaltyPoints struct {
+	CorporationID uint32 `json:"corporation_id"`
+	LoyaltyPoints int32  `json:"loyalty_points"`
+}
+
+// MedalGraphic is a single layer of a medals image
+type MedalGraphic struct {
+	Color   int32  `json:"color,omitempty"`
+	Graphic string `json:"graphic"`
+	Layer   int32  `json:"layer"`
+	Part    int32  `json:"part"`
+}
+
+// Medal is a medal that was awarded to the character
+type Medal struct {
+	CorporationID uint32         `json:"corporation_id"`
+	Date          time.Time      `json:"date"`
+	Description   string         `json:"description"`
+	Graphics      []MedalGraphic `json:"graphics"`
+	IssuerID      uint32         `json:"issuer_id"`
+	MedalID       int32          `json:"medal_id"`
+	Reason        string         `json:"reason"`
+	Status        string         `json:"status"`
+	Title         string         `json:"title"`
+}
+
+// AgentResearch is a research agent the character is working with
+type AgentResearch struct {
+	AgentID         uint32    `json:"agent_id"`
+	PointsPerDay    float32   `json:"points_per_day"`
+	RemainderPoints float32   `json:"remainder_points"`
+	SkillTypeID     uint32    `json:"skill_type_id"`
+	StartedAt       time.Time `json:"started_at"`
+}
+
+// Standing is the standing an npc agent, corporation or faction has towards the character
+type Standing struct {
+	FromID   uint32  `json:"from_id"`
+	FromType string  `json:"from_type"`
+	Standing float32 `json:"standing"`
+}
+
 func (esi Client) GetCharacterCorpHistory(characterID uint32) ([]CorporationHistory, error) {
 	var history []CorporationHistory
 	err := esi.get(fmt.Sprintf("/v2/characters/%d/corporationhistory/", characterID), &history)
@@ -84,35 +128,50 @@ func (esi Client) GetCharacterCorpHistory(characterID uint32) ([]CorporationHist
 
 // IsCharacterOnline gets if the character is currently online
 func (esi Client) IsCharacterOnline(characterID uint32, token string) (OnlineStatus, error) {
+	status, _, err := esi.getCharacterOnline(characterID, token)
+	return status, err
+}
+
+func (esi Client) getCharacterOnline(characterID uint32, token string) (OnlineStatus, time.Time, error) {
 	var status OnlineStatus
-	err := esi.authGet(fmt.Sprintf("/v3/characters/%d/online/", characterID), token, &status)
+	expires, err := esi.authGetExpires(fmt.Sprintf("/v3/characters/%d/online/", characterID), token, &status)
 	if err != nil {
-		return OnlineStatus{}, err
+		return OnlineStatus{}, time.Time{}, err
 	}
 
-	return status, nil
+	return status, expires, nil
 }
 
 // GetCharacterLocation get the character's current location
 func (esi Client) GetCharacterLocation(characterID uint32, token string) (Location, error) {
+	location, _, err := esi.getCharacterLocation(characterID, token)
+	return location, err
+}
+
+func (esi Client) getCharacterLocation(characterID uint32, token string) (Location, time.Time, error) {
 	var location Location
-	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/location/", characterID), token, &location)
+	expires, err := esi.authGetExpires(fmt.Sprintf("/v2/characters/%d/location/", characterID), token, &location)
 	if err != nil {
-		return Location{}, err
+		return Location{}, time.Time{}, err
 	}
 
-	return location, nil
+	return location, expires, nil
 }
 
 // GetCharacterShip get the character's current ship
 func (esi Client) GetCharacterShip(characterID uint32, token string) (Ship, error) {
+	ship, _, err := esi.getCharacterShip(characterID, token)
+	return ship, err
+}
+
+func (esi Client) getCharacterShip(characterID uint32, token string) (Ship, time.Time, error) {
 	var ship Ship
-	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/ship/", characterID), token, &ship)
+	expires, err := esi.authGetExpires(fmt.Sprintf("/v2/characters/%d/ship/", characterID), token, &ship)
 	if err != nil {
-		return Ship{}, err
+		return Ship{}, time.Time{}, err
 	}
 
-	return ship, nil
+	return ship, expires, nil
 }
 
 // GetCharacterRoles gets the current for this character
@@ -163,3 +222,57 @@ func (esi Client) GetCharacterAffiliations(ids []uint32) ([]Affiliation, error)
 
 	return affiliations, nil
 }
+
+// GetCharacterLoyaltyPoints gets the loyalty points the character has with each corporation
+func (esi Client) GetCharacterLoyaltyPoints(characterID uint32, token string) ([]LoyaltyPoints, error) {
+	var points []LoyaltyPoints
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/loyalty/points/", characterID), token, &points)
+	if err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+// GetCharacterMedals gets the medals awarded to the character
+func (esi Client) GetCharacterMedals(characterID uint32, token string) ([]Medal, error) {
+	var medals []Medal
+	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/medals/", characterID), token, &medals)
+	if err != nil {
+		return nil, err
+	}
+
+	return medals, nil
+}
+
+// GetCharacterAgentsResearch gets the research agents the character is working with
+func (esi Client) GetCharacterAgentsResearch(characterID uint32, token string) ([]AgentResearch, error) {
+	var research []AgentResearch
+	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/agents_research/", characterID), token, &research)
+	if err != nil {
+		return nil, err
+	}
+
+	return research, nil
+}
+
+// GetCharacterStandings gets the standings npc agents, corporations and factions have towards the character
+func (esi Client) GetCharacterStandings(characterID uint32, token string) ([]Standing, error) {
+	var standings []Standing
+	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/standings/", characterID), token, &standings)
+	if err != nil {
+		return nil, err
+	}
+
+	return standings, nil
+}
+
+// Points gets the total research points accumulated with the agent at the specified time
+func (research AgentResearch) Points(at time.Time) float64 {
+	days := at.Sub(research.StartedAt).Hours() / 24
+	if days < 0 {
+		days = 0
+	}
+
+	return float64(research.RemainderPoints) + float64(research.PointsPerDay)*days
+}
@@ -0,0 +1,172 @@
+package esi
+
+import (
+	"fmt"
+	"time"
+)
+
+// cloneJumpCooldown is the base time between clone jumps before Infomorph Synchronizing is applied
+const cloneJumpCooldown = 24 * time.Hour
+
+// CloneLocation is where a clone is currently stored
+type CloneLocation struct {
+	LocationID   uint64 `json:"location_id"`
+	LocationType string `json:"location_type"`
+}
+
+// JumpClone is a jump clone installed for the character
+type JumpClone struct {
+	Implants     []uint32 `json:"implants"`
+	JumpCloneID  int32    `json:"jump_clone_id"`
+	LocationID   uint64   `json:"location_id"`
+	LocationType string   `json:"location_type"`
+	Name         string   `json:"name,omitempty"`
+}
+
+// Clones is the characters home station and all installed jump clones
+type Clones struct {
+	HomeLocation          CloneLocation `json:"home_location,omitempty"`
+	JumpClones            []JumpClone   `json:"jump_clones"`
+	LastCloneJumpDate     *time.Time    `json:"last_clone_jump_date,omitempty"`
+	LastStationChangeDate *time.Time    `json:"last_station_change_date,omitempty"`
+}
+
+// Fatigue is the characters current jump fatigue
+type Fatigue struct {
+	JumpFatigueExpireDate *time.Time `json:"jump_fatigue_expire_date,omitempty"`
+	LastJumpDate          *time.Time `json:"last_jump_date,omitempty"`
+	LastUpdateDate        *time.Time `json:"last_update_date,omitempty"`
+}
+
+// Implant is an implant with its name resolved
+type Implant struct {
+	TypeID uint32
+	Name   string
+}
+
+// JumpCloneDetails is a jump clone with its location and implants resolved
+type JumpCloneDetails struct {
+	JumpClone
+	LocationName   string
+	SystemID       uint32
+	ImplantDetails []Implant
+}
+
+// GetCharacterClones gets the characters home location and jump clones
+func (esi Client) GetCharacterClones(characterID uint32, token string) (Clones, error) {
+	var clones Clones
+	err := esi.authGet(fmt.Sprintf("/v4/characters/%d/clones/", characterID), token, &clones)
+	if err != nil {
+		return Clones{}, err
+	}
+
+	return clones, nil
+}
+
+// GetCharacterImplants gets the type ids of the implants in the characters active clone
+func (esi Client) GetCharacterImplants(characterID uint32, token string) ([]uint32, error) {
+	var implants []uint32
+	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/implants/", characterID), token, &implants)
+	if err != nil {
+		return nil, err
+	}
+
+	return implants, nil
+}
+
+// GetCharacterFatigue gets the characters jump fatigue
+func (esi Client) GetCharacterFatigue(characterID uint32, token string) (Fatigue, error) {
+	var fatigue Fatigue
+	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/fatigue/", characterID), token, &fatigue)
+	if err != nil {
+		return Fatigue{}, err
+	}
+
+	return fatigue, nil
+}
+
+// NextCloneJump gets when the character can clone jump again, each level of Infomorph Synchronizing takes an hour off the cooldown
+func (clones Clones) NextCloneJump(synchronizingLevel int) time.Time {
+	if clones.LastCloneJumpDate == nil {
+		return time.Time{}
+	}
+
+	return clones.LastCloneJumpDate.Add(cloneJumpCooldown - time.Duration(synchronizingLevel)*time.Hour)
+}
+
+// CanCloneJump checks if the clone jump cooldown has passed
+func (clones Clones) CanCloneJump(synchronizingLevel int, now time.Time) bool {
+	return !now.Before(clones.NextCloneJump(synchronizingLevel))
+}
+
+// Expires gets when the characters jump fatigue wears off, a zero time means they aren't fatigued
+func (fatigue Fatigue) Expires() time.Time {
+	if fatigue.JumpFatigueExpireDate == nil {
+		return time.Time{}
+	}
+
+	return *fatigue.JumpFatigueExpireDate
+}
+
+// Remaining gets how much jump fatigue the character has left
+func (fatigue Fatigue) Remaining(now time.Time) time.Duration {
+	expires := fatigue.Expires()
+	if expires.Before(now) {
+		return 0
+	}
+
+	return expires.Sub(now)
+}
+
+// GetJumpCloneDetails gets the characters jump clones with their locations and implant names resolved
+func (esi Client) GetJumpCloneDetails(characterID uint32, token string) ([]JumpCloneDetails, error) {
+	clones, err := esi.GetCharacterClones(characterID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[uint32]string{}
+	details := make([]JumpCloneDetails, 0, len(clones.JumpClones))
+
+	for _, clone := range clones.JumpClones {
+		detail := JumpCloneDetails{JumpClone: clone}
+
+		switch clone.LocationType {
+		case "station":
+			station, err := esi.GetStation(uint32(clone.LocationID))
+			if err != nil {
+				return nil, err
+			}
+
+			detail.LocationName = station.Name
+			detail.SystemID = station.SystemID
+		case "structure":
+			// Clones can sit in structures the character has since lost docking rights to
+			structure, err := esi.GetStructure(clone.LocationID, token)
+			if err == nil {
+				detail.LocationName = structure.Name
+				detail.SystemID = structure.SolarSystemID
+			}
+		}
+
+		for _, implantID := range clone.Implants {
+			if _, ok := names[implantID]; !ok {
+				implant, err := esi.GetType(implantID)
+				if err != nil {
+					return nil, err
+				}
+
+				names[implantID] = implant.Name
+			}
+
+			detail.ImplantDetails = append(detail.ImplantDetails, Implant{
+				TypeID: implantID,
+				Name:   names[implantID],
+			})
+		}
+
+		details = append(details, detail)
+	}
+
+	return details, nil
+}
@@ -0,0 +1,220 @@
+package esi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// StandingLevel is how a standing value is displayed in game
+type StandingLevel int
+
+const (
+	StandingTerrible StandingLevel = iota - 2
+	StandingBad
+	StandingNeutral
+	StandingGood
+	StandingExcellent
+)
+
+// Contact is a character, corporation, alliance or faction on a contact list
+type Contact struct {
+	ContactID   uint32   `json:"contact_id"`
+	ContactType string   `json:"contact_type"`
+	IsBlocked   bool     `json:"is_blocked,omitempty"`
+	IsWatched   bool     `json:"is_watched,omitempty"`
+	LabelIDs    []uint64 `json:"label_ids,omitempty"`
+	Standing    float32  `json:"standing"`
+}
+
+// ContactLabel is a label used to group contacts
+type ContactLabel struct {
+	LabelID   uint64 `json:"label_id"`
+	LabelName string `json:"label_name"`
+}
+
+// GetCharacterContacts gets every contact on the characters contact list
+func (esi Client) GetCharacterContacts(characterID uint32, token string) ([]Contact, error) {
+	return getAllPages[Contact](esi, fmt.Sprintf("/v2/characters/%d/contacts/", characterID), token)
+}
+
+// GetCharacterContactLabels gets the labels the character has created for their contacts
+func (esi Client) GetCharacterContactLabels(characterID uint32, token string) ([]ContactLabel, error) {
+	var labels []ContactLabel
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/contacts/labels/", characterID), token, &labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// GetCorporationContacts gets every contact on the corporations contact list
+func (esi Client) GetCorporationContacts(corporationID uint32, token string) ([]Contact, error) {
+	return getAllPages[Contact](esi, fmt.Sprintf("/v2/corporations/%d/contacts/", corporationID), token)
+}
+
+// GetCorporationContactLabels gets the labels the corporation has created for its contacts
+func (esi Client) GetCorporationContactLabels(corporationID uint32, token string) ([]ContactLabel, error) {
+	var labels []ContactLabel
+	err := esi.authGet(fmt.Sprintf("/v1/corporations/%d/contacts/labels/", corporationID), token, &labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// GetAllianceContacts gets every contact on the alliances contact list
+func (esi Client) GetAllianceContacts(allianceID uint32, token string) ([]Contact, error) {
+	return getAllPages[Contact](esi, fmt.Sprintf("/v2/alliances/%d/contacts/", allianceID), token)
+}
+
+// GetAllianceContactLabels gets the labels the alliance has created for its contacts
+func (esi Client) GetAllianceContactLabels(allianceID uint32, token string) ([]ContactLabel, error) {
+	var labels []ContactLabel
+	err := esi.authGet(fmt.Sprintf("/v1/alliances/%d/contacts/labels/", allianceID), token, &labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// AddCharacterContacts adds the ids to the characters contact list with the specified standing.
+// ESI only supports editing character contact lists, corporation and alliance lists are read only.
+func (esi Client) AddCharacterContacts(characterID uint32, contactIDs []uint32, standing float32, labelIDs []uint64, watched bool, token string) ([]uint32, error) {
+	buffer, err := json.Marshal(contactIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []uint32
+	err = esi.authPost(contactsPath(characterID, standing, labelIDs, watched), token, buffer, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// EditCharacterContacts updates the standing, labels and watch state of contacts already on the characters list
+func (esi Client) EditCharacterContacts(characterID uint32, contactIDs []uint32, standing float32, labelIDs []uint64, watched bool, token string) error {
+	buffer, err := json.Marshal(contactIDs)
+	if err != nil {
+		return err
+	}
+
+	return esi.authPut(contactsPath(characterID, standing, labelIDs, watched), token, buffer, nil)
+}
+
+// DeleteCharacterContacts removes the ids from the characters contact list
+func (esi Client) DeleteCharacterContacts(characterID uint32, contactIDs []uint32, token string) error {
+	ids := make([]string, len(contactIDs))
+	for i, id := range contactIDs {
+		ids[i] = fmt.Sprint(id)
+	}
+
+	query := url.Values{}
+	query.Set("contact_ids", strings.Join(ids, ","))
+
+	return esi.authDelete(fmt.Sprintf("/v2/characters/%d/contacts/?%s", characterID, query.Encode()), token)
+}
+
+func contactsPath(characterID uint32, standing float32, labelIDs []uint64, watched bool) string {
+	query := url.Values{}
+	query.Set("standing", fmt.Sprint(standing))
+	query.Set("watched", fmt.Sprint(watched))
+
+	if len(labelIDs) > 0 {
+		labels := make([]string, len(labelIDs))
+		for i, id := range labelIDs {
+			labels[i] = fmt.Sprint(id)
+		}
+
+		query.Set("label_ids", strings.Join(labels, ","))
+	}
+
+	return fmt.Sprintf("/v2/characters/%d/contacts/?%s", characterID, query.Encode())
+}
+
+// Standings evaluates the effective standing towards other pilots from a character, corporation and alliance contact list
+type Standings struct {
+	owner       Affiliation
+	character   map[uint32]float32
+	corporation map[uint32]float32
+	alliance    map[uint32]float32
+}
+
+// CreateStandings creates a new evaluator for the owner using their character, corporation and alliance contacts
+func CreateStandings(owner Affiliation, character []Contact, corporation []Contact, alliance []Contact) *Standings {
+	return &Standings{
+		owner:       owner,
+		character:   mapContacts(character),
+		corporation: mapContacts(corporation),
+		alliance:    mapContacts(alliance),
+	}
+}
+
+func mapContacts(contacts []Contact) map[uint32]float32 {
+	standings := map[uint32]float32{}
+	for _, contact := range contacts {
+		standings[contact.ContactID] = contact.Standing
+	}
+
+	return standings
+}
+
+// Standing gets the effective standing towards the pilot. Character contacts take precedence over
+// corporation contacts, which take precedence over alliance contacts. Within a list the most specific
+// entry wins (character, then corporation, then alliance, then faction).
+func (standings Standings) Standing(pilot Affiliation) float32 {
+	if pilot.CharacterID != 0 && pilot.CharacterID == standings.owner.CharacterID {
+		return 10
+	}
+
+	if pilot.CorpID != 0 && pilot.CorpID == standings.owner.CorpID {
+		return 10
+	}
+
+	if pilot.AllianceID != 0 && pilot.AllianceID == standings.owner.AllianceID {
+		return 10
+	}
+
+	targets := []uint32{pilot.CharacterID, pilot.CorpID, pilot.AllianceID, pilot.FactionID}
+	for _, list := range []map[uint32]float32{standings.character, standings.corporation, standings.alliance} {
+		for _, target := range targets {
+			if target == 0 {
+				continue
+			}
+
+			if standing, ok := list[target]; ok {
+				return standing
+			}
+		}
+	}
+
+	return 0
+}
+
+// Level gets the display level (red, neutral, blue) of the effective standing towards the pilot
+func (standings Standings) Level(pilot Affiliation) StandingLevel {
+	return ToStandingLevel(standings.Standing(pilot))
+}
+
+// ToStandingLevel converts a standing value into the level it is displayed as in game
+func ToStandingLevel(standing float32) StandingLevel {
+	switch {
+	case standing > 5:
+		return StandingExcellent
+	case standing > 0:
+		return StandingGood
+	case standing < -5:
+		return StandingTerrible
+	case standing < 0:
+		return StandingBad
+	}
+
+	return StandingNeutral
+}
@@ -0,0 +1,202 @@
+package esi
+
+import (
+	"fmt"
+	"time"
+)
+
+// ContractType is the kind of contract
+type ContractType string
+
+const (
+	ContractUnknown      ContractType = "unknown"
+	ContractItemExchange ContractType = "item_exchange"
+	ContractAuction      ContractType = "auction"
+	ContractCourier      ContractType = "courier"
+	ContractLoan         ContractType = "loan"
+)
+
+// ContractStatus is the current state of a contract
+type ContractStatus string
+
+const (
+	ContractOutstanding        ContractStatus = "outstanding"
+	ContractInProgress         ContractStatus = "in_progress"
+	ContractFinishedIssuer     ContractStatus = "finished_issuer"
+	ContractFinishedContractor ContractStatus = "finished_contractor"
+	ContractFinished           ContractStatus = "finished"
+	ContractCancelled          ContractStatus = "cancelled"
+	ContractRejected           ContractStatus = "rejected"
+	ContractFailed             ContractStatus = "failed"
+	ContractDeleted            ContractStatus = "deleted"
+	ContractReversed           ContractStatus = "reversed"
+)
+
+// Contract is a contract issued by or to a character or corporation, or available publicly in a region
+type Contract struct {
+	AcceptorID          uint32         `json:"acceptor_id,omitempty"`
+	AssigneeID          uint32         `json:"assignee_id,omitempty"`
+	Availability        string         `json:"availability,omitempty"`
+	Buyout              float64        `json:"buyout,omitempty"`
+	Collateral          float64        `json:"collateral,omitempty"`
+	ContractID          int32          `json:"contract_id"`
+	DateAccepted        *time.Time     `json:"date_accepted,omitempty"`
+	DateCompleted       *time.Time     `json:"date_completed,omitempty"`
+	DateExpired         time.Time      `json:"date_expired"`
+	DateIssued          time.Time      `json:"date_issued"`
+	DaysToComplete      int32          `json:"days_to_complete,omitempty"`
+	EndLocationID       int64          `json:"end_location_id,omitempty"`
+	ForCorporation      bool           `json:"for_corporation,omitempty"`
+	IssuerCorporationID uint32         `json:"issuer_corporation_id"`
+	IssuerID            uint32         `json:"issuer_id"`
+	Price               float64        `json:"price,omitempty"`
+	Reward              float64        `json:"reward,omitempty"`
+	StartLocationID     int64          `json:"start_location_id,omitempty"`
+	Status              ContractStatus `json:"status,omitempty"`
+	Title               string         `json:"title,omitempty"`
+	Type                ContractType   `json:"type"`
+	Volume              float64        `json:"volume,omitempty"`
+}
+
+// ContractItem is an item offered or requested by a contract
+type ContractItem struct {
+	IsBlueprintCopy    bool   `json:"is_blueprint_copy,omitempty"`
+	IsIncluded         bool   `json:"is_included"`
+	IsSingleton        bool   `json:"is_singleton,omitempty"`
+	ItemID             int64  `json:"item_id,omitempty"`
+	MaterialEfficiency int32  `json:"material_efficiency,omitempty"`
+	Quantity           int32  `json:"quantity"`
+	RawQuantity        int32  `json:"raw_quantity,omitempty"`
+	RecordID           int64  `json:"record_id"`
+	Runs               int32  `json:"runs,omitempty"`
+	TimeEfficiency     int32  `json:"time_efficiency,omitempty"`
+	TypeID             uint32 `json:"type_id"`
+}
+
+// ContractBid is a bid placed on an auction contract
+type ContractBid struct {
+	Amount   float64   `json:"amount"`
+	BidID    int32     `json:"bid_id"`
+	BidderID uint32    `json:"bidder_id,omitempty"`
+	DateBid  time.Time `json:"date_bid"`
+}
+
+// ContractAppraisal is the estimated value of a contract compared to what it is asking for
+type ContractAppraisal struct {
+	Contract Contract
+	Offered  float64
+	Wanted   float64
+	Asking   float64
+	Unpriced []uint32
+}
+
+// GetCharacterContracts gets every page of the contracts available to or issued by the character
+func (esi Client) GetCharacterContracts(characterID uint32, token string) ([]Contract, error) {
+	return getAllPages[Contract](esi, fmt.Sprintf("/v1/characters/%d/contracts/", characterID), token)
+}
+
+// GetCharacterContractItems gets the items in one of the characters contracts
+func (esi Client) GetCharacterContractItems(characterID uint32, contractID int32, token string) ([]ContractItem, error) {
+	var items []ContractItem
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/contracts/%d/items/", characterID, contractID), token, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// GetCharacterContractBids gets the bids on one of the characters auction contracts
+func (esi Client) GetCharacterContractBids(characterID uint32, contractID int32, token string) ([]ContractBid, error) {
+	var bids []ContractBid
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/contracts/%d/bids/", characterID, contractID), token, &bids)
+	if err != nil {
+		return nil, err
+	}
+
+	return bids, nil
+}
+
+// GetCorporationContracts gets every page of the contracts available to or issued by the corporation
+func (esi Client) GetCorporationContracts(corporationID uint32, token string) ([]Contract, error) {
+	return getAllPages[Contract](esi, fmt.Sprintf("/v1/corporations/%d/contracts/", corporationID), token)
+}
+
+// GetCorporationContractItems gets the items in one of the corporations contracts
+func (esi Client) GetCorporationContractItems(corporationID uint32, contractID int32, token string) ([]ContractItem, error) {
+	var items []ContractItem
+	err := esi.authGet(fmt.Sprintf("/v1/corporations/%d/contracts/%d/items/", corporationID, contractID), token, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// GetCorporationContractBids gets every page of bids on one of the corporations auction contracts
+func (esi Client) GetCorporationContractBids(corporationID uint32, contractID int32, token string) ([]ContractBid, error) {
+	return getAllPages[ContractBid](esi, fmt.Sprintf("/v1/corporations/%d/contracts/%d/bids/", corporationID, contractID), token)
+}
+
+// GetPublicContracts gets every page of public contracts in a region
+func (esi Client) GetPublicContracts(regionID uint32) ([]Contract, error) {
+	return getAllPages[Contract](esi, fmt.Sprintf("/v1/contracts/public/%d/", regionID), "")
+}
+
+// GetPublicContractItems gets every page of items in a public contract
+func (esi Client) GetPublicContractItems(contractID int32) ([]ContractItem, error) {
+	return getAllPages[ContractItem](esi, fmt.Sprintf("/v1/contracts/public/items/%d/", contractID), "")
+}
+
+// GetPublicContractBids gets every page of bids on a public auction contract
+func (esi Client) GetPublicContractBids(contractID int32) ([]ContractBid, error) {
+	return getAllPages[ContractBid](esi, fmt.Sprintf("/v1/contracts/public/bids/%d/", contractID), "")
+}
+
+// AppraiseContract prices a contracts items using the prices map (type id -> isk per unit), ex: from GetMarketPrices.
+// Blueprint copies have no market value and types missing from prices are listed in Unpriced.
+func AppraiseContract(contract Contract, items []ContractItem, prices map[uint32]float64) ContractAppraisal {
+	appraisal := ContractAppraisal{
+		Contract: contract,
+		Asking:   contract.Price,
+	}
+
+	if contract.Type == ContractAuction && contract.Buyout > 0 {
+		appraisal.Asking = contract.Buyout
+	}
+
+	for _, item := range items {
+		if item.IsBlueprintCopy {
+			continue
+		}
+
+		price, ok := prices[item.TypeID]
+		if !ok {
+			appraisal.Unpriced = append(appraisal.Unpriced, item.TypeID)
+			continue
+		}
+
+		if item.IsIncluded {
+			appraisal.Offered += price * float64(item.Quantity)
+		} else {
+			appraisal.Wanted += price * float64(item.Quantity)
+		}
+	}
+
+	return appraisal
+}
+
+// Value gets the net value of the contract for the person accepting it, items received minus items given and isk paid
+func (appraisal ContractAppraisal) Value() float64 {
+	return appraisal.Offered - appraisal.Wanted - appraisal.Asking + appraisal.Contract.Reward
+}
+
+// IsUnderpriced checks if an item exchange is asking for less than ratio of the value of its items (ex: 0.8 for 20% under value)
+func (appraisal ContractAppraisal) IsUnderpriced(ratio float64) bool {
+	if appraisal.Contract.Type != ContractItemExchange && appraisal.Contract.Type != ContractAuction {
+		return false
+	}
+
+	net := appraisal.Offered - appraisal.Wanted
+	return net > 0 && appraisal.Asking < net*ratio
+}
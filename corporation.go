@@ -0,0 +1,93 @@
+package esi
+
+import (
+	"fmt"
+	"time"
+)
+
+// npc corporations are all allocated ids in this range
+const (
+	minNPCCorporationID uint32 = 1000000
+	maxNPCCorporationID uint32 = 2000000
+)
+
+// Corporation is the public information of a corporation
+type Corporation struct {
+	AllianceID    uint32     `json:"alliance_id,omitempty"`
+	CEOID         uint32     `json:"ceo_id"`
+	CreatorID     uint32     `json:"creator_id"`
+	DateFounded   *time.Time `json:"date_founded,omitempty"`
+	Description   string     `json:"description,omitempty"`
+	FactionID     uint32     `json:"faction_id,omitempty"`
+	HomeStationID uint32     `json:"home_station_id,omitempty"`
+	MemberCount   uint32     `json:"member_count"`
+	Name          string     `json:"name"`
+	Shares        int64      `json:"shares,omitempty"`
+	TaxRate       float32    `json:"tax_rate"`
+	Ticker        string     `json:"ticker"`
+	URL           string     `json:"url,omitempty"`
+	WarEligible   bool       `json:"war_eligible,omitempty"`
+}
+
+// AllianceHistory is a history record for an alliance the corporation belonged to, AllianceID is 0 while it wasn't in one
+type AllianceHistory struct {
+	AllianceID uint32    `json:"alliance_id,omitempty"`
+	Deleted    bool      `json:"is_deleted,omitempty"`
+	RecordID   uint32    `json:"record_id"`
+	StartDate  time.Time `json:"start_date"`
+}
+
+// CorporationIcons is the urls of the corporations logo in each size
+type CorporationIcons struct {
+	Px64x64   string `json:"px64x64,omitempty"`
+	Px128x128 string `json:"px128x128,omitempty"`
+	Px256x256 string `json:"px256x256,omitempty"`
+}
+
+// IsNPCCorporation gets if the id belongs to an npc corporation
+func IsNPCCorporation(corporationID uint32) bool {
+	return corporationID >= minNPCCorporationID && corporationID < maxNPCCorporationID
+}
+
+// IsNPC gets if the corporation is run by an npc, the ceo of an npc corporation is also an npc
+func (corporation Corporation) IsNPC() bool {
+	return corporation.CreatorID == 1 || (corporation.CEOID >= 3000000 && corporation.CEOID < 4000000)
+}
+
+// DescriptionMarkup parses the corporations description
+func (corporation Corporation) DescriptionMarkup() *MarkupNode {
+	return ParseMarkup(corporation.Description)
+}
+
+// GetCorporation gets the public information of a corporation
+func (esi Client) GetCorporation(corporationID uint32) (Corporation, error) {
+	var corporation Corporation
+	err := esi.get(fmt.Sprintf("/v5/corporations/%d/", corporationID), &corporation)
+	if err != nil {
+		return Corporation{}, err
+	}
+
+	return corporation, nil
+}
+
+// GetCorporationAllianceHistory gets the alliances the corporation has been a member of, newest first
+func (esi Client) GetCorporationAllianceHistory(corporationID uint32) ([]AllianceHistory, error) {
+	var history []AllianceHistory
+	err := esi.get(fmt.Sprintf("/v3/corporations/%d/alliancehistory/", corporationID), &history)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// GetCorporationIcons gets the urls of the corporations logo
+func (esi Client) GetCorporationIcons(corporationID uint32) (CorporationIcons, error) {
+	var icons CorporationIcons
+	err := esi.get(fmt.Sprintf("/v2/corporations/%d/icons/", corporationID), &icons)
+	if err != nil {
+		return CorporationIcons{}, err
+	}
+
+	return icons, nil
+}
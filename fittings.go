@@ -0,0 +1,203 @@
+package esi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// dogma effect ids that mark which slot a module fits into
+const (
+	effectLoPower     uint32 = 11
+	effectHiPower     uint32 = 12
+	effectMedPower    uint32 = 13
+	effectRigSlot     uint32 = 2663
+	effectSubSystem   uint32 = 3772
+	effectServiceSlot uint32 = 6306
+)
+
+// FittingItem is a module, charge, drone or cargo item in a saved fitting
+type FittingItem struct {
+	Flag     string `json:"flag"`
+	Quantity int32  `json:"quantity"`
+	TypeID   uint32 `json:"type_id"`
+}
+
+// Fitting is a fitting saved to the characters in game fittings
+type Fitting struct {
+	Description string        `json:"description"`
+	FittingID   int32         `json:"fitting_id,omitempty"`
+	Items       []FittingItem `json:"items"`
+	Name        string        `json:"name"`
+	ShipTypeID  uint32        `json:"ship_type_id"`
+}
+
+type fittingCreated struct {
+	FittingID int32 `json:"fitting_id"`
+}
+
+type slotGroup struct {
+	items  map[uint32]*KillItem
+	prefix string
+	effect uint32
+}
+
+// GetCharacterFittings gets the characters saved fittings
+func (esi Client) GetCharacterFittings(characterID uint32, token string) ([]Fitting, error) {
+	var fittings []Fitting
+	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/fittings/", characterID), token, &fittings)
+	if err != nil {
+		return nil, err
+	}
+
+	return fittings, nil
+}
+
+// CreateFitting saves a new fitting for the character and returns its id
+func (esi Client) CreateFitting(characterID uint32, fitting Fitting, token string) (int32, error) {
+	fitting.FittingID = 0
+	buffer, err := json.Marshal(fitting)
+	if err != nil {
+		return 0, err
+	}
+
+	var created fittingCreated
+	err = esi.authPost(fmt.Sprintf("/v2/characters/%d/fittings/", characterID), token, buffer, &created)
+	if err != nil {
+		return 0, err
+	}
+
+	return created.FittingID, nil
+}
+
+// DeleteFitting deletes one of the characters saved fittings
+func (esi Client) DeleteFitting(characterID uint32, fittingID int32, token string) error {
+	return esi.authDelete(fmt.Sprintf("/v1/characters/%d/fittings/%d/", characterID, fittingID), token)
+}
+
+// ToFitting converts a KillFitting into a fitting that can be saved in game.
+// Charges loaded into modules share their module's flag on a killmail, so each slot item is checked
+// against its dogma effects and anything that isn't a module is moved into the cargo.
+func (esi Client) ToFitting(fit *KillFitting, shipTypeID uint32, name string, description string) (*Fitting, error) {
+	fitting := &Fitting{
+		Description: description,
+		Name:        name,
+		ShipTypeID:  shipTypeID,
+	}
+
+	groups := []slotGroup{
+		{fit.HighSlot, "HiSlot", effectHiPower},
+		{fit.MedSlot, "MedSlot", effectMedPower},
+		{fit.LoSlot, "LoSlot", effectLoPower},
+		{fit.RigSlot, "RigSlot", effectRigSlot},
+		{fit.SubSystemSlot, "SubSystemSlot", effectSubSystem},
+		{fit.ServiceSlot, "ServiceSlot", effectServiceSlot},
+	}
+
+	cargo := map[uint32]int32{}
+	for _, group := range groups {
+		slot := 0
+		for _, item := range sortKillItems(group.items) {
+			isModule, err := esi.hasEffect(item.ID, group.effect)
+			if err != nil {
+				return nil, err
+			}
+
+			quantity := int32(item.QuantityDestroyed + item.QuantityDropped)
+			if !isModule {
+				cargo[item.ID] += quantity
+				continue
+			}
+
+			// Identical modules are merged on the KillFitting, so split them back out into a slot each
+			for i := int32(0); i < quantity; i++ {
+				fitting.Items = append(fitting.Items, FittingItem{
+					Flag:     fmt.Sprintf("%s%d", group.prefix, slot),
+					Quantity: 1,
+					TypeID:   item.ID,
+				})
+				slot++
+			}
+		}
+	}
+
+	for _, item := range sortKillItems(fit.Cargo) {
+		cargo[item.ID] += int32(item.QuantityDestroyed + item.QuantityDropped)
+	}
+
+	fitting.Items = append(fitting.Items, bayItems("DroneBay", fit.DroneBay)...)
+	fitting.Items = append(fitting.Items, bayItems("FighterBay", fit.FighterBay)...)
+
+	typeIDs := make([]uint32, 0, len(cargo))
+	for typeID := range cargo {
+		typeIDs = append(typeIDs, typeID)
+	}
+	sort.Slice(typeIDs, func(i, j int) bool { return typeIDs[i] < typeIDs[j] })
+
+	for _, typeID := range typeIDs {
+		fitting.Items = append(fitting.Items, FittingItem{Flag: "Cargo", Quantity: cargo[typeID], TypeID: typeID})
+	}
+
+	return fitting, nil
+}
+
+// SaveKillMailFitting saves the fitting of a killmail's victim ship to the characters in game fittings
+func (esi Client) SaveKillMailFitting(characterID uint32, killID uint32, hash string, name string, token string) (int32, error) {
+	killmail, fit, err := esi.GetKillMail(killID, hash, true)
+	if err != nil {
+		return 0, err
+	}
+
+	fitting, err := esi.ToFitting(fit, killmail.Victim.ShipTypeID, name, fmt.Sprintf("Saved from killmail %d", killID))
+	if err != nil {
+		return 0, err
+	}
+
+	return esi.CreateFitting(characterID, *fitting, token)
+}
+
+func (esi Client) hasEffect(typeID uint32, effectID uint32) (bool, error) {
+	item, err := esi.GetType(typeID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, effect := range item.DogmaEffects {
+		if effect.EffectID == effectID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func bayItems(flag string, group map[uint32]*KillItem) []FittingItem {
+	var items []FittingItem
+	for _, item := range sortKillItems(group) {
+		items = append(items, FittingItem{
+			Flag:     flag,
+			Quantity: int32(item.QuantityDestroyed + item.QuantityDropped),
+			TypeID:   item.ID,
+		})
+	}
+
+	return items
+}
+
+// sortKillItems orders a fitting group by its original flag so slots are assigned the same way every time
+func sortKillItems(group map[uint32]*KillItem) []*KillItem {
+	items := make([]*KillItem, 0, len(group))
+	for _, item := range group {
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Flag != items[j].Flag {
+			return items[i].Flag < items[j].Flag
+		}
+
+		return items[i].ID < items[j].ID
+	})
+
+	return items
+}
@@ -0,0 +1,295 @@
+package esi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// FleetRole is the position a member holds in the fleet
+type FleetRole string
+
+const (
+	FleetCommander FleetRole = "fleet_commander"
+	WingCommander  FleetRole = "wing_commander"
+	SquadCommander FleetRole = "squad_commander"
+	SquadMember    FleetRole = "squad_member"
+)
+
+// CharacterFleet is the fleet the character is currently in
+type CharacterFleet struct {
+	FleetID int64     `json:"fleet_id"`
+	Role    FleetRole `json:"role"`
+	SquadID int64     `json:"squad_id"`
+	WingID  int64     `json:"wing_id"`
+}
+
+// Fleet is the settings of a fleet
+type Fleet struct {
+	IsFreeMove     bool   `json:"is_free_move"`
+	IsRegistered   bool   `json:"is_registered"`
+	IsVoiceEnabled bool   `json:"is_voice_enabled"`
+	MOTD           string `json:"motd"`
+}
+
+// FleetUpdate is the settings of a fleet that can be changed, nil fields are left as they are
+type FleetUpdate struct {
+	IsFreeMove *bool   `json:"is_free_move,omitempty"`
+	MOTD       *string `json:"motd,omitempty"`
+}
+
+// FleetMember is a character in the fleet
+type FleetMember struct {
+	CharacterID    uint32    `json:"character_id"`
+	JoinTime       time.Time `json:"join_time"`
+	Role           FleetRole `json:"role"`
+	RoleName       string    `json:"role_name"`
+	ShipTypeID     uint32    `json:"ship_type_id"`
+	SolarSystemID  uint32    `json:"solar_system_id"`
+	SquadID        int64     `json:"squad_id"`
+	StationID      int64     `json:"station_id,omitempty"`
+	TakesFleetWarp bool      `json:"takes_fleet_warp"`
+	WingID         int64     `json:"wing_id"`
+}
+
+// FleetSquad is a squad in a wing
+type FleetSquad struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// FleetWing is a wing in the fleet with its squads
+type FleetWing struct {
+	ID     int64        `json:"id"`
+	Name   string       `json:"name"`
+	Squads []FleetSquad `json:"squads"`
+}
+
+// FleetMovement is where a member is invited or moved to, wing and squad commanders need a wing id and squad members need both
+type FleetMovement struct {
+	CharacterID uint32    `json:"character_id,omitempty"`
+	Role        FleetRole `json:"role"`
+	SquadID     int64     `json:"squad_id,omitempty"`
+	WingID      int64     `json:"wing_id,omitempty"`
+}
+
+// FleetCompositionMember is a fleet member with their ship and system names resolved
+type FleetCompositionMember struct {
+	FleetMember
+	ShipName   string
+	SystemName string
+}
+
+// FleetComposition is a snapshot of everyone in the fleet, grouped by the ship they are flying
+type FleetComposition struct {
+	Taken   time.Time
+	Members []FleetCompositionMember
+	Ships   map[string]int
+	Systems map[string]int
+}
+
+type fleetName struct {
+	Name string `json:"name"`
+}
+
+type wingCreated struct {
+	WingID int64 `json:"wing_id"`
+}
+
+type squadCreated struct {
+	SquadID int64 `json:"squad_id"`
+}
+
+// GetCharacterFleet gets the fleet the character is currently in
+func (esi Client) GetCharacterFleet(characterID uint32, token string) (CharacterFleet, error) {
+	var fleet CharacterFleet
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/fleet/", characterID), token, &fleet)
+	if err != nil {
+		return CharacterFleet{}, err
+	}
+
+	return fleet, nil
+}
+
+// GetFleet gets the settings of a fleet, the token must belong to the fleet boss
+func (esi Client) GetFleet(fleetID int64, token string) (Fleet, error) {
+	var fleet Fleet
+	err := esi.authGet(fmt.Sprintf("/v1/fleets/%d/", fleetID), token, &fleet)
+	if err != nil {
+		return Fleet{}, err
+	}
+
+	return fleet, nil
+}
+
+// UpdateFleet changes the free move setting and/or motd of a fleet
+func (esi Client) UpdateFleet(fleetID int64, update FleetUpdate, token string) error {
+	buffer, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	return esi.authPut(fmt.Sprintf("/v1/fleets/%d/", fleetID), token, buffer, nil)
+}
+
+// SetFleetMOTD changes the message of the day of a fleet
+func (esi Client) SetFleetMOTD(fleetID int64, motd string, token string) error {
+	return esi.UpdateFleet(fleetID, FleetUpdate{MOTD: &motd}, token)
+}
+
+// GetFleetMembers gets everyone in the fleet with their ship and location
+func (esi Client) GetFleetMembers(fleetID int64, token string) ([]FleetMember, error) {
+	var members []FleetMember
+	err := esi.authGet(fmt.Sprintf("/v1/fleets/%d/members/", fleetID), token, &members)
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// InviteFleetMember sends a fleet invite to a character for the specified position
+func (esi Client) InviteFleetMember(fleetID int64, invite FleetMovement, token string) error {
+	buffer, err := json.Marshal(invite)
+	if err != nil {
+		return err
+	}
+
+	return esi.authPost(fmt.Sprintf("/v1/fleets/%d/members/", fleetID), token, buffer, nil)
+}
+
+// MoveFleetMember moves a member to a different position in the fleet
+func (esi Client) MoveFleetMember(fleetID int64, memberID uint32, movement FleetMovement, token string) error {
+	movement.CharacterID = 0
+	buffer, err := json.Marshal(movement)
+	if err != nil {
+		return err
+	}
+
+	return esi.authPut(fmt.Sprintf("/v1/fleets/%d/members/%d/", fleetID, memberID), token, buffer, nil)
+}
+
+// KickFleetMember removes a member from the fleet
+func (esi Client) KickFleetMember(fleetID int64, memberID uint32, token string) error {
+	return esi.authDelete(fmt.Sprintf("/v1/fleets/%d/members/%d/", fleetID, memberID), token)
+}
+
+// GetFleetWings gets the wings and squads of the fleet
+func (esi Client) GetFleetWings(fleetID int64, token string) ([]FleetWing, error) {
+	var wings []FleetWing
+	err := esi.authGet(fmt.Sprintf("/v1/fleets/%d/wings/", fleetID), token, &wings)
+	if err != nil {
+		return nil, err
+	}
+
+	return wings, nil
+}
+
+// CreateFleetWing creates a new wing in the fleet and returns its id
+func (esi Client) CreateFleetWing(fleetID int64, token string) (int64, error) {
+	var created wingCreated
+	err := esi.authPost(fmt.Sprintf("/v1/fleets/%d/wings/", fleetID), token, nil, &created)
+	if err != nil {
+		return 0, err
+	}
+
+	return created.WingID, nil
+}
+
+// RenameFleetWing changes the name of a wing
+func (esi Client) RenameFleetWing(fleetID int64, wingID int64, name string, token string) error {
+	buffer, err := json.Marshal(fleetName{Name: name})
+	if err != nil {
+		return err
+	}
+
+	return esi.authPut(fmt.Sprintf("/v1/fleets/%d/wings/%d/", fleetID, wingID), token, buffer, nil)
+}
+
+// DeleteFleetWing removes a wing from the fleet, it needs to be empty first
+func (esi Client) DeleteFleetWing(fleetID int64, wingID int64, token string) error {
+	return esi.authDelete(fmt.Sprintf("/v1/fleets/%d/wings/%d/", fleetID, wingID), token)
+}
+
+// CreateFleetSquad creates a new squad in a wing and returns its id
+func (esi Client) CreateFleetSquad(fleetID int64, wingID int64, token string) (int64, error) {
+	var created squadCreated
+	err := esi.authPost(fmt.Sprintf("/v1/fleets/%d/wings/%d/squads/", fleetID, wingID), token, nil, &created)
+	if err != nil {
+		return 0, err
+	}
+
+	return created.SquadID, nil
+}
+
+// RenameFleetSquad changes the name of a squad
+func (esi Client) RenameFleetSquad(fleetID int64, squadID int64, name string, token string) error {
+	buffer, err := json.Marshal(fleetName{Name: name})
+	if err != nil {
+		return err
+	}
+
+	return esi.authPut(fmt.Sprintf("/v1/fleets/%d/squads/%d/", fleetID, squadID), token, buffer, nil)
+}
+
+// DeleteFleetSquad removes a squad from the fleet, it needs to be empty first
+func (esi Client) DeleteFleetSquad(fleetID int64, squadID int64, token string) error {
+	return esi.authDelete(fmt.Sprintf("/v1/fleets/%d/squads/%d/", fleetID, squadID), token)
+}
+
+// GetFleetComposition gets the fleet members and resolves the names of their ships and systems
+func (esi Client) GetFleetComposition(fleetID int64, token string) (*FleetComposition, error) {
+	members, err := esi.GetFleetMembers(fleetID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	unique := map[uint]bool{}
+	var ids []uint
+	for _, member := range members {
+		for _, id := range []uint{uint(member.ShipTypeID), uint(member.SolarSystemID)} {
+			if id != 0 && !unique[id] {
+				unique[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	names := map[uint]NameRef{}
+	if len(ids) > 0 {
+		names, err = esi.GetNames(ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	composition := &FleetComposition{
+		Taken:   time.Now(),
+		Members: make([]FleetCompositionMember, 0, len(members)),
+		Ships:   map[string]int{},
+		Systems: map[string]int{},
+	}
+
+	for _, member := range members {
+		entry := FleetCompositionMember{
+			FleetMember: member,
+			ShipName:    names[uint(member.ShipTypeID)].Name,
+			SystemName:  names[uint(member.SolarSystemID)].Name,
+		}
+
+		composition.Ships[entry.ShipName]++
+		composition.Systems[entry.SystemName]++
+		composition.Members = append(composition.Members, entry)
+	}
+
+	sort.Slice(composition.Members, func(i, j int) bool {
+		if composition.Members[i].ShipName != composition.Members[j].ShipName {
+			return composition.Members[i].ShipName < composition.Members[j].ShipName
+		}
+
+		return composition.Members[i].CharacterID < composition.Members[j].CharacterID
+	})
+
+	return composition, nil
+}
@@ -3,3 +3,5 @@ module github.com/w9jds/go.esi
 go 1.23
 
 require k8s.io/klog v1.0.0
+
+require gopkg.in/yaml.v3 v3.0.1
@@ -0,0 +1,141 @@
+package esi
+
+import (
+	"fmt"
+	"time"
+)
+
+// IndustryActivity is the type of work an industry job is doing
+type IndustryActivity int32
+
+const (
+	ActivityManufacturing      IndustryActivity = 1
+	ActivityTimeEfficiency     IndustryActivity = 3
+	ActivityMaterialEfficiency IndustryActivity = 4
+	ActivityCopying            IndustryActivity = 5
+	ActivityReverseEngineering IndustryActivity = 7
+	ActivityInvention          IndustryActivity = 8
+	ActivityReactions          IndustryActivity = 9
+	ActivityLegacyReactions    IndustryActivity = 11
+)
+
+// IndustryStatus is the current state of an industry job
+type IndustryStatus string
+
+const (
+	IndustryActive    IndustryStatus = "active"
+	IndustryCancelled IndustryStatus = "cancelled"
+	IndustryDelivered IndustryStatus = "delivered"
+	IndustryPaused    IndustryStatus = "paused"
+	IndustryReady     IndustryStatus = "ready"
+	IndustryReverted  IndustryStatus = "reverted"
+)
+
+// IndustryJob is a manufacturing, research, invention or reaction job
+type IndustryJob struct {
+	ActivityID           IndustryActivity `json:"activity_id"`
+	BlueprintID          int64            `json:"blueprint_id"`
+	BlueprintLocationID  int64            `json:"blueprint_location_id"`
+	BlueprintTypeID      uint32           `json:"blueprint_type_id"`
+	CompletedCharacterID uint32           `json:"completed_character_id,omitempty"`
+	CompletedDate        *time.Time       `json:"completed_date,omitempty"`
+	Cost                 float64          `json:"cost,omitempty"`
+	Duration             int32            `json:"duration"`
+	EndDate              time.Time        `json:"end_date"`
+	FacilityID           int64            `json:"facility_id"`
+	InstallerID          uint32           `json:"installer_id"`
+	JobID                int32            `json:"job_id"`
+	LicensedRuns         int32            `json:"licensed_runs,omitempty"`
+	LocationID           int64            `json:"location_id,omitempty"`
+	OutputLocationID     int64            `json:"output_location_id"`
+	PauseDate            *time.Time       `json:"pause_date,omitempty"`
+	Probability          float32          `json:"probability,omitempty"`
+	ProductTypeID        uint32           `json:"product_type_id,omitempty"`
+	Runs                 int32            `json:"runs"`
+	StartDate            time.Time        `json:"start_date"`
+	StationID            int64            `json:"station_id,omitempty"`
+	Status               IndustryStatus   `json:"status"`
+	SuccessfulRuns       int32            `json:"successful_runs,omitempty"`
+}
+
+// IndustrySnapshot is the list of jobs as they were at a point in time
+type IndustrySnapshot struct {
+	Taken time.Time
+	Jobs  []IndustryJob
+}
+
+// IndustryChanges are the jobs that changed state between two snapshots
+type IndustryChanges struct {
+	Ready     []IndustryJob
+	Delivered []IndustryJob
+	Cancelled []IndustryJob
+
+	// Closed are open jobs that are missing from the current snapshot, which happens when it was taken without completed jobs
+	Closed []IndustryJob
+}
+
+// GetCharacterIndustryJobs gets the characters industry jobs, including completed jobs from the last 90 days when requested
+func (esi Client) GetCharacterIndustryJobs(characterID uint32, includeCompleted bool, token string) ([]IndustryJob, error) {
+	var jobs []IndustryJob
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/industry/jobs/?include_completed=%t", characterID, includeCompleted), token, &jobs)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// GetCorporationIndustryJobs gets every page of the corporations industry jobs
+func (esi Client) GetCorporationIndustryJobs(corporationID uint32, includeCompleted bool, token string) ([]IndustryJob, error) {
+	return getAllPages[IndustryJob](esi, fmt.Sprintf("/v1/corporations/%d/industry/jobs/?include_completed=%t", corporationID, includeCompleted), token)
+}
+
+// IsReady checks if the job is ready to be delivered, esi keeps jobs as active after their end date so that is checked as well
+func (job IndustryJob) IsReady(at time.Time) bool {
+	return job.Status == IndustryReady || (job.Status == IndustryActive && !job.EndDate.After(at))
+}
+
+// DiffIndustryJobs compares two snapshots and reports jobs that became ready, were delivered or were cancelled.
+// Delivered and cancelled jobs are only reported when they were open in the previous snapshot, so completed history isn't repeated.
+// Both snapshots need to be fetched with includeCompleted to tell delivered from cancelled, otherwise finished jobs
+// drop out of the list and are reported as Closed.
+func DiffIndustryJobs(previous IndustrySnapshot, current IndustrySnapshot) IndustryChanges {
+	before := map[int32]IndustryJob{}
+	for _, job := range previous.Jobs {
+		before[job.JobID] = job
+	}
+
+	after := map[int32]bool{}
+	for _, job := range current.Jobs {
+		after[job.JobID] = true
+	}
+
+	var changes IndustryChanges
+	for _, job := range previous.Jobs {
+		finished := job.Status == IndustryDelivered || job.Status == IndustryCancelled || job.Status == IndustryReverted
+		if !after[job.JobID] && !finished {
+			changes.Closed = append(changes.Closed, job)
+		}
+	}
+
+	for _, job := range current.Jobs {
+		old, seen := before[job.JobID]
+
+		switch job.Status {
+		case IndustryDelivered:
+			if seen && old.Status != IndustryDelivered {
+				changes.Delivered = append(changes.Delivered, job)
+			}
+		case IndustryCancelled, IndustryReverted:
+			if seen && old.Status != IndustryCancelled && old.Status != IndustryReverted {
+				changes.Cancelled = append(changes.Cancelled, job)
+			}
+		default:
+			if job.IsReady(current.Taken) && (!seen || !old.IsReady(previous.Taken)) {
+				changes.Ready = append(changes.Ready, job)
+			}
+		}
+	}
+
+	return changes
+}
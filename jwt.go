@@ -0,0 +1,299 @@
+package esi
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Scopes    scopeList   `json:"scp"`
+	Subject   string      `json:"sub"`
+	Audience  audienceSet `json:"aud"`
+	Issuer    string      `json:"iss"`
+	Expires   int64       `json:"exp"`
+	Name      string      `json:"name"`
+	Owner     string      `json:"owner"`
+	IssuedAt  int64       `json:"iat"`
+	NotBefore int64       `json:"nbf,omitempty"`
+}
+
+// scopeList handles the scp claim being either a single string or an array
+type scopeList []string
+
+func (scopes *scopeList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*scopes = scopeList{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+
+	*scopes = list
+	return nil
+}
+
+// audienceSet handles the aud claim being either a single string or an array
+type audienceSet []string
+
+func (audience *audienceSet) UnmarshalJSON(data []byte) error {
+	var list scopeList
+	if err := list.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	*audience = audienceSet(list)
+	return nil
+}
+
+func (audience audienceSet) contains(value string) bool {
+	for _, entry := range audience {
+		if entry == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+type jsonWebKey struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	KeyType   string `json:"kty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// ValidateToken verifies the signature and claims of an sso access token and returns the character it belongs to
+func (sso *SSO) ValidateToken(accessToken string) (*AuthCharacter, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("access token is not a valid jwt")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+
+	key, err := sso.getKey(header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifySignature(header.Algorithm, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	return sso.validateClaims(claims)
+}
+
+func (sso *SSO) validateClaims(claims jwtClaims) (*AuthCharacter, error) {
+	if claims.Issuer != ssoHost && claims.Issuer != "https://"+ssoHost {
+		return nil, fmt.Errorf("access token was issued by an unexpected issuer %s", claims.Issuer)
+	}
+
+	if !claims.Audience.contains(sso.clientID) || !claims.Audience.contains("EVE Online") {
+		return nil, errors.New("access token was not issued for this application")
+	}
+
+	now := time.Now()
+	expires := time.Unix(claims.Expires, 0)
+	if now.After(expires) {
+		return nil, errors.New("access token has expired")
+	}
+
+	if claims.NotBefore != 0 && now.Before(time.Unix(claims.NotBefore, 0)) {
+		return nil, errors.New("access token is not valid yet")
+	}
+
+	subject := strings.Split(claims.Subject, ":")
+	if len(subject) != 3 || subject[0] != "CHARACTER" {
+		return nil, fmt.Errorf("access token has an unexpected subject %s", claims.Subject)
+	}
+
+	id, err := strconv.ParseUint(subject[2], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthCharacter{
+		ID:        uint32(id),
+		Name:      claims.Name,
+		Owner:     claims.Owner,
+		Scopes:    claims.Scopes,
+		ExpiresAt: expires,
+	}, nil
+}
+
+func (sso *SSO) getKey(keyID string) (interface{}, error) {
+	sso.keysMutex.Lock()
+	defer sso.keysMutex.Unlock()
+
+	if key, ok := sso.keys[keyID]; ok {
+		return key, nil
+	}
+
+	// Keys are rotated by CCP from time to time, so reload them when an unknown id shows up
+	keys, err := sso.fetchKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	sso.keys = keys
+	if key, ok := sso.keys[keyID]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("signing key %s was not found", keyID)
+}
+
+func (sso *SSO) fetchKeys() (map[string]interface{}, error) {
+	response, err := sso.client.Get(ssoKeysURI)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to retrieve sso signing keys with status %d", response.StatusCode)
+	}
+
+	var set jsonWebKeySet
+	if err := json.NewDecoder(response.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]interface{}{}
+	for _, key := range set.Keys {
+		switch key.KeyType {
+		case "RSA":
+			public, err := parseRSAKey(key)
+			if err != nil {
+				return nil, err
+			}
+			keys[key.KeyID] = public
+		case "EC":
+			public, err := parseECKey(key)
+			if err != nil {
+				return nil, err
+			}
+			keys[key.KeyID] = public
+		}
+	}
+
+	return keys, nil
+}
+
+func parseRSAKey(key jsonWebKey) (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(new(big.Int).SetBytes(exponent).Int64()),
+	}, nil
+}
+
+func parseECKey(key jsonWebKey) (*ecdsa.PublicKey, error) {
+	if key.Curve != "P-256" {
+		return nil, fmt.Errorf("unsupported curve %s", key.Curve)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(key.X)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(key.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}, nil
+}
+
+func verifySignature(algorithm string, key interface{}, signed string, signature []byte) error {
+	hash := sha256.Sum256([]byte(signed))
+
+	switch algorithm {
+	case "RS256":
+		public, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("signing key does not match the token algorithm")
+		}
+
+		return rsa.VerifyPKCS1v15(public, crypto.SHA256, hash[:], signature)
+	case "ES256":
+		public, ok := key.(*ecdsa.PublicKey)
+		if !ok || len(signature) != 64 {
+			return errors.New("signing key does not match the token algorithm")
+		}
+
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(public, hash[:], r, s) {
+			return errors.New("access token signature is invalid")
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unsupported signing algorithm %s", algorithm)
+}
+
+func decodeSegment(segment string, result interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, result)
+}
@@ -1,7 +1,10 @@
 package esi
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 )
 
 // KillMail that is recieved from eve online
@@ -63,6 +66,12 @@ type KillFitting struct {
 	DroneBay      map[uint32]*KillItem
 }
 
+// KillMailRef is the id and hash needed to look up a killmail
+type KillMailRef struct {
+	Hash string `json:"killmail_hash"`
+	ID   uint32 `json:"killmail_id"`
+}
+
 // GetKillMail retrieves a specific killmail from ESI
 func (esi Client) GetKillMail(killID uint32, hash string, withFitting bool) (*KillMail, *KillFitting, error) {
 	var killmail KillMail
@@ -78,6 +87,71 @@ func (esi Client) GetKillMail(killID uint32, hash string, withFitting bool) (*Ki
 	return &killmail, nil, nil
 }
 
+// GetCharacterKillMailRefs gets every page of the characters recent kills and losses
+func (esi Client) GetCharacterKillMailRefs(characterID uint32, token string) ([]KillMailRef, error) {
+	return getAllPages[KillMailRef](esi, fmt.Sprintf("/v1/characters/%d/killmails/recent/", characterID), token)
+}
+
+// GetCorporationKillMailRefs gets every page of the corporations recent kills and losses
+func (esi Client) GetCorporationKillMailRefs(corporationID uint32, token string) ([]KillMailRef, error) {
+	return getAllPages[KillMailRef](esi, fmt.Sprintf("/v1/corporations/%d/killmails/recent/", corporationID), token)
+}
+
+// HydrateKillMails retrieves the full killmail for each ref using at most concurrency requests at a time.
+// Refs that seen reports as already processed are skipped, pass nil to fetch everything.
+// Killmails that were retrieved are returned even if others failed, along with the joined errors.
+func (esi Client) HydrateKillMails(refs []KillMailRef, concurrency int, seen func(killID uint32) bool) ([]KillMail, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mutex     sync.Mutex
+		wait      sync.WaitGroup
+		killmails []KillMail
+		failures  []error
+	)
+
+	queue := make(chan KillMailRef)
+	for i := 0; i < concurrency; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+
+			for ref := range queue {
+				killmail, _, err := esi.GetKillMail(ref.ID, ref.Hash, false)
+
+				mutex.Lock()
+				if err != nil {
+					failures = append(failures, fmt.Errorf("killmail %d: %w", ref.ID, err))
+				} else {
+					killmails = append(killmails, *killmail)
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	queued := map[uint32]bool{}
+	for _, ref := range refs {
+		if queued[ref.ID] || (seen != nil && seen(ref.ID)) {
+			continue
+		}
+
+		queued[ref.ID] = true
+		queue <- ref
+	}
+
+	close(queue)
+	wait.Wait()
+
+	sort.Slice(killmails, func(i, j int) bool {
+		return killmails[i].ID > killmails[j].ID
+	})
+
+	return killmails, errors.Join(failures...)
+}
+
 func updateFittingItem(group map[uint32]*KillItem, item KillItem) {
 	if current, ok := group[item.ID]; ok {
 		current.QuantityDestroyed += item.QuantityDestroyed
@@ -94,6 +168,8 @@ func buildShipFitting(killmail KillMail) *KillFitting {
 		MedSlot:       map[uint32]*KillItem{},
 		LoSlot:        map[uint32]*KillItem{},
 		RigSlot:       map[uint32]*KillItem{},
+		FighterBay:    map[uint32]*KillItem{},
+		ServiceSlot:   map[uint32]*KillItem{},
 		Cargo:         map[uint32]*KillItem{},
 		DroneBay:      map[uint32]*KillItem{},
 	}
@@ -113,6 +189,10 @@ func buildShipFitting(killmail KillMail) *KillFitting {
 			updateFittingItem(fit.RigSlot, item)
 		} else if item.Flag >= 125 && item.Flag <= 128 {
 			updateFittingItem(fit.SubSystemSlot, item)
+		} else if item.Flag == 158 {
+			updateFittingItem(fit.FighterBay, item)
+		} else if item.Flag >= 164 && item.Flag <= 171 {
+			updateFittingItem(fit.ServiceSlot, item)
 		}
 	}
 
@@ -0,0 +1,258 @@
+package esi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mailSendInterval is the minimum time between mails sent by the same character before esi starts rejecting them
+const mailSendInterval = 15 * time.Second
+
+// MailRecipient is a character, corporation, alliance or mailing list a mail was sent to
+type MailRecipient struct {
+	RecipientID   uint32 `json:"recipient_id"`
+	RecipientType string `json:"recipient_type"`
+}
+
+// MailHeader is the summary of a mail in the characters inbox
+type MailHeader struct {
+	From       uint32          `json:"from,omitempty"`
+	IsRead     bool            `json:"is_read,omitempty"`
+	Labels     []int32         `json:"labels,omitempty"`
+	MailID     int32           `json:"mail_id"`
+	Recipients []MailRecipient `json:"recipients,omitempty"`
+	Subject    string          `json:"subject,omitempty"`
+	Timestamp  time.Time       `json:"timestamp"`
+}
+
+// Mail is the full contents of a mail
+type Mail struct {
+	Body       string          `json:"body,omitempty"`
+	From       uint32          `json:"from,omitempty"`
+	Labels     []int32         `json:"labels,omitempty"`
+	Read       bool            `json:"read,omitempty"`
+	Recipients []MailRecipient `json:"recipients,omitempty"`
+	Subject    string          `json:"subject,omitempty"`
+	Timestamp  time.Time       `json:"timestamp"`
+}
+
+// MailLabel is a label used to organize the characters mail
+type MailLabel struct {
+	Color       string `json:"color,omitempty"`
+	LabelID     int32  `json:"label_id"`
+	Name        string `json:"name"`
+	UnreadCount int32  `json:"unread_count,omitempty"`
+}
+
+// MailLabels is all of the characters mail labels and the total unread count
+type MailLabels struct {
+	Labels           []MailLabel `json:"labels"`
+	TotalUnreadCount int32       `json:"total_unread_count,omitempty"`
+}
+
+// MailingList is a mailing list the character is subscribed to
+type MailingList struct {
+	MailingListID uint32 `json:"mailing_list_id"`
+	Name          string `json:"name"`
+}
+
+// NewMail is a mail that is going to be sent by the character
+type NewMail struct {
+	ApprovedCost int64           `json:"approved_cost,omitempty"`
+	Body         string          `json:"body"`
+	Recipients   []MailRecipient `json:"recipients"`
+	Subject      string          `json:"subject"`
+}
+
+type mailUpdate struct {
+	Labels []int32 `json:"labels,omitempty"`
+	Read   bool    `json:"read"`
+}
+
+// CSPAChargeError is returned when a recipient charges isk (CONCORD Spam Prevention Act) to receive mail.
+// Resend the mail with ApprovedCost set to Charge to pay it.
+type CSPAChargeError struct {
+	Charge float64
+}
+
+func (err CSPAChargeError) Error() string {
+	return fmt.Sprintf("sending this mail requires approving a cspa charge of %.2f isk", err.Charge)
+}
+
+type mailThrottle struct {
+	mutex sync.Mutex
+	next  map[uint32]time.Time
+}
+
+func createMailThrottle() *mailThrottle {
+	return &mailThrottle{
+		next: map[uint32]time.Time{},
+	}
+}
+
+// wait blocks until the character is allowed to send another mail and reserves the slot
+func (throttle *mailThrottle) wait(characterID uint32) {
+	throttle.mutex.Lock()
+	now := time.Now()
+	slot := throttle.next[characterID]
+	if slot.Before(now) {
+		slot = now
+	}
+
+	throttle.next[characterID] = slot.Add(mailSendInterval)
+	throttle.mutex.Unlock()
+
+	time.Sleep(slot.Sub(now))
+}
+
+// GetCharacterMail gets up to 50 mail headers older than lastMailID (0 for the newest), optionally filtered by labels
+func (esi Client) GetCharacterMail(characterID uint32, labels []int32, lastMailID int32, token string) ([]MailHeader, error) {
+	query := url.Values{}
+	if len(labels) > 0 {
+		values := make([]string, len(labels))
+		for i, label := range labels {
+			values[i] = fmt.Sprint(label)
+		}
+
+		query.Set("labels", strings.Join(values, ","))
+	}
+
+	if lastMailID > 0 {
+		query.Set("last_mail_id", fmt.Sprint(lastMailID))
+	}
+
+	path := fmt.Sprintf("/v1/characters/%d/mail/", characterID)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var headers []MailHeader
+	err := esi.authGet(path, token, &headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return headers, nil
+}
+
+// GetMail gets the contents of a single mail
+func (esi Client) GetMail(characterID uint32, mailID int32, token string) (*Mail, error) {
+	var mail Mail
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/mail/%d/", characterID, mailID), token, &mail)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mail, nil
+}
+
+// UpdateMail sets the read state and labels of a mail
+func (esi Client) UpdateMail(characterID uint32, mailID int32, read bool, labels []int32, token string) error {
+	buffer, err := json.Marshal(mailUpdate{Labels: labels, Read: read})
+	if err != nil {
+		return err
+	}
+
+	return esi.authPut(fmt.Sprintf("/v1/characters/%d/mail/%d/", characterID, mailID), token, buffer, nil)
+}
+
+// MarkMailRead marks a mail as read or unread without changing its labels
+func (esi Client) MarkMailRead(characterID uint32, mailID int32, read bool, token string) error {
+	return esi.UpdateMail(characterID, mailID, read, nil, token)
+}
+
+// DeleteMail deletes a mail from the characters inbox
+func (esi Client) DeleteMail(characterID uint32, mailID int32, token string) error {
+	return esi.authDelete(fmt.Sprintf("/v1/characters/%d/mail/%d/", characterID, mailID), token)
+}
+
+// GetMailLabels gets the characters mail labels and unread counts
+func (esi Client) GetMailLabels(characterID uint32, token string) (MailLabels, error) {
+	var labels MailLabels
+	err := esi.authGet(fmt.Sprintf("/v3/characters/%d/mail/labels/", characterID), token, &labels)
+	if err != nil {
+		return MailLabels{}, err
+	}
+
+	return labels, nil
+}
+
+// CreateMailLabel creates a new mail label and returns its id
+func (esi Client) CreateMailLabel(characterID uint32, name string, color string, token string) (int32, error) {
+	buffer, err := json.Marshal(MailLabel{Name: name, Color: color})
+	if err != nil {
+		return 0, err
+	}
+
+	var labelID int32
+	err = esi.authPost(fmt.Sprintf("/v2/characters/%d/mail/labels/", characterID), token, buffer, &labelID)
+	if err != nil {
+		return 0, err
+	}
+
+	return labelID, nil
+}
+
+// DeleteMailLabel deletes one of the characters mail labels
+func (esi Client) DeleteMailLabel(characterID uint32, labelID int32, token string) error {
+	return esi.authDelete(fmt.Sprintf("/v1/characters/%d/mail/labels/%d/", characterID, labelID), token)
+}
+
+// GetMailingLists gets the mailing lists the character is subscribed to
+func (esi Client) GetMailingLists(characterID uint32, token string) ([]MailingList, error) {
+	var lists []MailingList
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/mail/lists/", characterID), token, &lists)
+	if err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+// SendMail sends a mail from the character and returns the new mail id.
+// Sends are spaced out per character to stay under the esi mail throttle, and a CSPAChargeError is returned when a recipient charges for mail.
+func (esi Client) SendMail(characterID uint32, mail NewMail, token string) (int32, error) {
+	buffer, err := json.Marshal(mail)
+	if err != nil {
+		return 0, err
+	}
+
+	if esi.mail != nil {
+		esi.mail.wait(characterID)
+	}
+
+	var mailID int32
+	err = esi.authPost(fmt.Sprintf("/v1/characters/%d/mail/", characterID), token, buffer, &mailID)
+
+	var status StatusError
+	if errors.As(err, &status) {
+		var charge struct {
+			Charge *float64 `json:"cspa_charge"`
+		}
+
+		if json.Unmarshal(status.Body, &charge) == nil && charge.Charge != nil {
+			return 0, CSPAChargeError{Charge: *charge.Charge}
+		}
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return mailID, nil
+}
+
+// Text converts the eve markup in the mail body into plain text
+func (mail Mail) Text() string {
+	return MailBodyToText(mail.Body)
+}
+
+// MailBodyToText converts eve markup (<font>, <a>, <br> etc) into plain text with ParseMarkup, it is kept for existing callers
+func MailBodyToText(body string) string {
+	return ParseMarkup(body).PlainText()
+}
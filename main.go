@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"k8s.io/klog"
@@ -15,6 +17,7 @@ import (
 type Client struct {
 	baseURI string
 	client  *http.Client
+	mail    *mailThrottle
 }
 
 const baseURI = "https://esi.evetech.net"
@@ -24,6 +27,7 @@ func CreateClient(httpClient *http.Client) *Client {
 	return &Client{
 		baseURI: baseURI,
 		client:  httpClient,
+		mail:    createMailThrottle(),
 	}
 }
 
@@ -43,7 +47,7 @@ func (esi Client) get(path string, result interface{}) error {
 		return err
 	}
 
-	data, err := esi.do(attachHeaders(request))
+	data, _, err := esi.do(attachHeaders(request))
 	if err != nil {
 		return err
 	}
@@ -56,13 +60,42 @@ func (esi Client) get(path string, result interface{}) error {
 }
 
 func (esi Client) authGet(path string, token string, result interface{}) error {
+	_, err := esi.authGetExpires(path, token, result)
+	return err
+}
+
+// authGetExpires makes an authenticated get and returns when esi will have fresh data for it (from the Expires header)
+func (esi Client) authGetExpires(path string, token string, result interface{}) (time.Time, error) {
 	request, err := http.NewRequest("GET", baseURI+path, nil)
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
 	request = authHeader(request, token)
-	data, err := esi.do(attachHeaders(request))
+	data, header, err := esi.do(attachHeaders(request))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := json.Unmarshal(data, result); err != nil {
+		return time.Time{}, err
+	}
+
+	expires, err := http.ParseTime(header.Get("Expires"))
+	if err != nil {
+		return time.Time{}, nil
+	}
+
+	return expires, nil
+}
+
+func (esi Client) post(path string, content []byte, result interface{}) error {
+	request, err := http.NewRequest("POST", baseURI+path, bytes.NewBuffer(content))
+	if err != nil {
+		return err
+	}
+
+	data, _, err := esi.do(attachHeaders(request))
 	if err != nil {
 		return err
 	}
@@ -74,17 +107,40 @@ func (esi Client) authGet(path string, token string, result interface{}) error {
 	return nil
 }
 
-func (esi Client) post(path string, content []byte, result interface{}) error {
-	request, err := http.NewRequest("POST", baseURI+path, bytes.NewBuffer(content))
+// Writes aren't idempotent (ex: a mail that times out may have been sent), so they only get one attempt
+func (esi Client) authPost(path string, token string, content []byte, result interface{}) error {
+	return esi.authSend("POST", path, token, content, result, 1)
+}
+
+// authPostRead is for endpoints that use a post to look data up (ex: asset names), which are safe to retry
+func (esi Client) authPostRead(path string, token string, content []byte, result interface{}) error {
+	return esi.authSend("POST", path, token, content, result, 3)
+}
+
+func (esi Client) authPut(path string, token string, content []byte, result interface{}) error {
+	return esi.authSend("PUT", path, token, content, result, 1)
+}
+
+func (esi Client) authDelete(path string, token string) error {
+	return esi.authSend("DELETE", path, token, nil, nil, 1)
+}
+
+func (esi Client) authSend(method string, path string, token string, content []byte, result interface{}, attempts int) error {
+	request, err := http.NewRequest(method, baseURI+path, bytes.NewBuffer(content))
 	if err != nil {
 		return err
 	}
 
-	data, err := esi.do(attachHeaders(request))
+	request = authHeader(request, token)
+	data, _, err := esi.send(attachHeaders(request), attempts)
 	if err != nil {
 		return err
 	}
 
+	if result == nil || len(data) == 0 {
+		return nil
+	}
+
 	if err := json.Unmarshal(data, result); err != nil {
 		return err
 	}
@@ -92,14 +148,81 @@ func (esi Client) post(path string, content []byte, result interface{}) error {
 	return nil
 }
 
+// authGetPage gets a single page of a paginated endpoint and returns the total number of pages
+func (esi Client) authGetPage(path string, token string, page int, result interface{}) (int, error) {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	request, err := http.NewRequest("GET", fmt.Sprintf("%s%s%spage=%d", baseURI, path, separator, page), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if token != "" {
+		request = authHeader(request, token)
+	}
+
+	data, header, err := esi.do(attachHeaders(request))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := json.Unmarshal(data, result); err != nil {
+		return 0, err
+	}
+
+	pages, err := strconv.Atoi(header.Get("X-Pages"))
+	if err != nil {
+		return 1, nil
+	}
+
+	return pages, nil
+}
+
+// getAllPages walks every page of a paginated endpoint and joins the results together
+func getAllPages[T any](esi Client, path string, token string) ([]T, error) {
+	var results []T
+
+	for page, pages := 1, 1; page <= pages; page++ {
+		var items []T
+		total, err := esi.authGetPage(path, token, page, &items)
+		if err != nil {
+			return nil, err
+		}
+
+		pages = total
+		results = append(results, items...)
+	}
+
+	return results, nil
+}
+
 type ResponseError struct {
 	Path       string
 	StatusCode int
 	Error      error
 }
 
-func (esi Client) do(request *http.Request) ([]byte, error) {
-	for i := 0; i < 3; i++ {
+// StatusError is returned when esi responds with a status that the caller needs to handle itself
+type StatusError struct {
+	Path       string
+	StatusCode int
+	Body       []byte
+}
+
+func (err StatusError) Error() string {
+	return fmt.Sprintf("request %s failed with status %d: %s", err.Path, err.StatusCode, err.Body)
+}
+
+func (esi Client) do(request *http.Request) ([]byte, http.Header, error) {
+	return esi.send(request, 3)
+}
+
+// send makes the request, retrying failures until it has been tried the number of attempts
+func (esi Client) send(request *http.Request, attempts int) ([]byte, http.Header, error) {
+	for i := 0; i < attempts; i++ {
 		delay := 5 * time.Second
 
 		response, error := esi.client.Do(request)
@@ -108,6 +231,11 @@ func (esi Client) do(request *http.Request) ([]byte, error) {
 				Path:  request.URL.Path,
 				Error: error,
 			})
+
+			if attempts == 1 {
+				return nil, nil, error
+			}
+
 			time.Sleep(delay)
 			continue
 		} else if response.StatusCode < 200 || response.StatusCode > 299 {
@@ -119,15 +247,43 @@ func (esi Client) do(request *http.Request) ([]byte, error) {
 			// Don't bother retrying three times when you don't have permissions to make the request in the first place
 			if response.StatusCode == 403 || response.StatusCode == 401 {
 				klog.Error(log)
+				if attempts == 1 {
+					message, _ := io.ReadAll(response.Body)
+					return nil, nil, StatusError{
+						Path:       request.URL.Path,
+						StatusCode: response.StatusCode,
+						Body:       message,
+					}
+				}
+
 				break
 			}
 
 			message, error := io.ReadAll(response.Body)
 
+			// ESI uses 520 for game specific failures (ex: cspa charges) and retrying won't change them
+			if response.StatusCode == 520 {
+				log.Error = fmt.Errorf("%s", message)
+				klog.Error(log)
+				return nil, nil, StatusError{
+					Path:       request.URL.Path,
+					StatusCode: response.StatusCode,
+					Body:       message,
+				}
+			}
+
 			// Don't bother retrying three times when rate limited
 			if response.StatusCode == 420 || response.StatusCode == 404 {
 				log.Error = fmt.Errorf("%s", message)
 				klog.Error(log)
+				if attempts == 1 {
+					return nil, nil, StatusError{
+						Path:       request.URL.Path,
+						StatusCode: response.StatusCode,
+						Body:       message,
+					}
+				}
+
 				break
 			}
 
@@ -138,14 +294,23 @@ func (esi Client) do(request *http.Request) ([]byte, error) {
 			}
 
 			klog.Error(log)
+			if attempts == 1 {
+				return nil, nil, StatusError{
+					Path:       request.URL.Path,
+					StatusCode: response.StatusCode,
+					Body:       message,
+				}
+			}
+
 			time.Sleep(delay)
 			continue
 		} else {
-			return io.ReadAll(response.Body)
+			data, error := io.ReadAll(response.Body)
+			return data, response.Header, error
 		}
 	}
 
-	return nil, fmt.Errorf("Failed Request %s After 3 Tries", request.URL.Path)
+	return nil, nil, fmt.Errorf("Failed Request %s After %d Tries", request.URL.Path, attempts)
 }
 
 func (esi Client) getIds(path string) ([]uint32, error) {
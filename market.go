@@ -2,6 +2,7 @@ package esi
 
 import (
 	"fmt"
+	"time"
 )
 
 // MarketGroup is a group that appears on the market
@@ -13,6 +14,51 @@ type MarketGroup struct {
 	Types         []uint32 `json:"types,omitempty"`
 }
 
+// MarketOrder is a buy or sell order placed by a character or corporation
+type MarketOrder struct {
+	Duration       int32     `json:"duration"`
+	Escrow         float64   `json:"escrow,omitempty"`
+	IsBuyOrder     bool      `json:"is_buy_order,omitempty"`
+	IsCorporation  bool      `json:"is_corporation,omitempty"`
+	Issued         time.Time `json:"issued"`
+	IssuedBy       uint32    `json:"issued_by,omitempty"`
+	LocationID     int64     `json:"location_id"`
+	MinVolume      int32     `json:"min_volume,omitempty"`
+	OrderID        int64     `json:"order_id"`
+	Price          float64   `json:"price"`
+	Range          string    `json:"range"`
+	RegionID       uint32    `json:"region_id"`
+	State          string    `json:"state,omitempty"`
+	TypeID         uint32    `json:"type_id"`
+	VolumeRemain   int32     `json:"volume_remain"`
+	VolumeTotal    int32     `json:"volume_total"`
+	WalletDivision int32     `json:"wallet_division,omitempty"`
+}
+
+// OrderFill is volume that was bought or sold from an order between snapshots
+type OrderFill struct {
+	Order  MarketOrder
+	Filled int32
+}
+
+// OrderModification is an order that had its price changed between snapshots
+type OrderModification struct {
+	Order         MarketOrder
+	PreviousPrice float64
+}
+
+// OrderChanges are all the changes to a single traders orders between snapshots
+type OrderChanges struct {
+	Filled    []OrderFill
+	Modified  []OrderModification
+	Completed []MarketOrder
+	Expired   []MarketOrder
+	Cancelled []MarketOrder
+
+	// Closed are orders that are no longer open but aren't in history yet, so it isn't known if they sold out or were cancelled
+	Closed []MarketOrder
+}
+
 // GetMarketGroupIds returns a list of all possible market group ids
 func (esi Client) GetMarketGroupIds() ([]uint32, error) {
 	return esi.getIds("/latest/markets/groups/")
@@ -28,3 +74,137 @@ func (esi Client) GetMarketGroup(id uint32) (*MarketGroup, error) {
 
 	return &group, error
 }
+
+// GetCharacterOrders gets the characters open market orders
+func (esi Client) GetCharacterOrders(characterID uint32, token string) ([]MarketOrder, error) {
+	var orders []MarketOrder
+	error := esi.authGet(fmt.Sprintf("/v2/characters/%d/orders/", characterID), token, &orders)
+	if error != nil {
+		return nil, error
+	}
+
+	return withIssuer(orders, characterID), nil
+}
+
+// GetCharacterOrderHistory gets every page of the characters closed market orders from the last 90 days
+func (esi Client) GetCharacterOrderHistory(characterID uint32, token string) ([]MarketOrder, error) {
+	orders, error := getAllPages[MarketOrder](esi, fmt.Sprintf("/v1/characters/%d/orders/history/", characterID), token)
+	if error != nil {
+		return nil, error
+	}
+
+	return withIssuer(orders, characterID), nil
+}
+
+// GetCorporationOrders gets every page of the corporations open market orders
+func (esi Client) GetCorporationOrders(corporationID uint32, token string) ([]MarketOrder, error) {
+	return getAllPages[MarketOrder](esi, fmt.Sprintf("/v3/corporations/%d/orders/", corporationID), token)
+}
+
+// GetCorporationOrderHistory gets every page of the corporations closed market orders from the last 90 days
+func (esi Client) GetCorporationOrderHistory(corporationID uint32, token string) ([]MarketOrder, error) {
+	return getAllPages[MarketOrder](esi, fmt.Sprintf("/v2/corporations/%d/orders/history/", corporationID), token)
+}
+
+// character orders don't include who issued them, so fill it in to keep them keyed the same as corporation orders
+func withIssuer(orders []MarketOrder, characterID uint32) []MarketOrder {
+	for i := range orders {
+		if orders[i].IssuedBy == 0 {
+			orders[i].IssuedBy = characterID
+		}
+	}
+
+	return orders
+}
+
+// Expires gets when the order will expire
+func (order MarketOrder) Expires() time.Time {
+	return order.Issued.Add(time.Duration(order.Duration) * 24 * time.Hour)
+}
+
+// DiffMarketOrders compares two snapshots of open orders and reports the changes grouped by the character that issued them.
+// Orders that are no longer open are looked up in history to tell if they expired, were cancelled or sold out (Completed),
+// ones that aren't in history yet are reported as Closed so they can be checked against history later.
+func DiffMarketOrders(previous []MarketOrder, current []MarketOrder, history []MarketOrder, now time.Time) map[uint32]*OrderChanges {
+	open := map[int64]MarketOrder{}
+	for _, order := range current {
+		open[order.OrderID] = order
+	}
+
+	closed := map[int64]MarketOrder{}
+	for _, order := range history {
+		closed[order.OrderID] = order
+	}
+
+	changes := map[uint32]*OrderChanges{}
+	trader := func(id uint32) *OrderChanges {
+		if _, ok := changes[id]; !ok {
+			changes[id] = &OrderChanges{}
+		}
+
+		return changes[id]
+	}
+
+	for _, before := range previous {
+		after, stillOpen := open[before.OrderID]
+		if !stillOpen {
+			if final, ok := closed[before.OrderID]; ok {
+				after = final
+			} else if now.Before(before.Expires()) {
+				// Not in history yet and not past its expiry, it either sold out or was cancelled so don't guess at fills
+				trader(before.IssuedBy).Closed = append(trader(before.IssuedBy).Closed, before)
+				continue
+			} else {
+				after = before
+				after.State = "expired"
+			}
+		}
+
+		if filled := before.VolumeRemain - after.VolumeRemain; filled > 0 {
+			trader(before.IssuedBy).Filled = append(trader(before.IssuedBy).Filled, OrderFill{Order: after, Filled: filled})
+		}
+
+		if stillOpen && after.Price != before.Price {
+			trader(before.IssuedBy).Modified = append(trader(before.IssuedBy).Modified, OrderModification{Order: after, PreviousPrice: before.Price})
+		}
+
+		// history marks sold out orders as expired too, only the ones with volume left actually ran out their duration
+		switch {
+		case after.State == "expired" && after.VolumeRemain == 0:
+			trader(before.IssuedBy).Completed = append(trader(before.IssuedBy).Completed, after)
+		case after.State == "expired":
+			trader(before.IssuedBy).Expired = append(trader(before.IssuedBy).Expired, after)
+		case after.State == "cancelled":
+			trader(before.IssuedBy).Cancelled = append(trader(before.IssuedBy).Cancelled, after)
+		}
+	}
+
+	return changes
+}
+
+// MarketPrice is the average and adjusted price of a type across all of New Eden
+type MarketPrice struct {
+	AdjustedPrice float64 `json:"adjusted_price,omitempty"`
+	AveragePrice  float64 `json:"average_price,omitempty"`
+	TypeID        uint32  `json:"type_id"`
+}
+
+// GetMarketPrices gets the average price of every type, falling back to the adjusted price when there is no average
+func (esi Client) GetMarketPrices() (map[uint32]float64, error) {
+	var prices []MarketPrice
+	error := esi.get("/v1/markets/prices/", &prices)
+	if error != nil {
+		return nil, error
+	}
+
+	values := map[uint32]float64{}
+	for _, price := range prices {
+		if price.AveragePrice > 0 {
+			values[price.TypeID] = price.AveragePrice
+		} else if price.AdjustedPrice > 0 {
+			values[price.TypeID] = price.AdjustedPrice
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,495 @@
+package esi
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MarkupNodeType is the kind of node in a parsed markup tree
+type MarkupNodeType int
+
+const (
+	MarkupDocument MarkupNodeType = iota
+	MarkupText
+	MarkupElement
+	MarkupBreak
+)
+
+// LinkKind is what an eve markup link points at
+type LinkKind int
+
+const (
+	LinkURL LinkKind = iota
+	LinkType
+	LinkItem
+	LinkCharacter
+	LinkCorporation
+	LinkAlliance
+	LinkFaction
+	LinkSolarSystem
+	LinkConstellation
+	LinkRegion
+	LinkStation
+	LinkKillMail
+	LinkUnknown
+)
+
+// type ids that showinfo links use to point at something other than an item type
+const (
+	showInfoRegion        uint32 = 3
+	showInfoConstellation uint32 = 4
+	showInfoSolarSystem   uint32 = 5
+	showInfoCorporation   uint32 = 2
+	showInfoFaction       uint32 = 30
+	showInfoAlliance      uint32 = 16159
+)
+
+// MarkupLink is a parsed link from an <a href=...> element
+type MarkupLink struct {
+	Kind         LinkKind
+	Href         string
+	TypeID       uint32
+	ItemID       uint64
+	KillMailID   uint32
+	KillMailHash string
+}
+
+// MarkupNode is a single node in a parsed markup tree
+type MarkupNode struct {
+	Type       MarkupNodeType
+	Tag        string
+	Text       string
+	Attributes map[string]string
+	Link       *MarkupLink
+	Children   []*MarkupNode
+	parent     *MarkupNode
+}
+
+// LinkResolver turns a typed link into a url for rendering, returning an empty string (or a non web url) leaves the link as plain text
+type LinkResolver func(link MarkupLink) string
+
+var (
+	markupAttribute  = regexp.MustCompile(`([a-zA-Z_:-]+)\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+)`)
+	markupColor      = regexp.MustCompile(`^#?([0-9a-fA-F]{2})?([0-9a-fA-F]{6})$`)
+	markupVoidTags   = map[string]bool{"br": true, "img": true, "hr": true}
+	markupSafeTags   = map[string]bool{"b": true, "i": true, "u": true, "p": true}
+	markupBlockTags  = map[string]bool{"p": true, "div": true}
+	markupHiddenTags = map[string]bool{"script": true, "style": true}
+	markupURLEscaper = strings.NewReplacer(
+		"(", "%28",
+		")", "%29",
+		" ", "%20",
+		"<", "%3C",
+		">", "%3E",
+		"\n", "%0A",
+		"\r", "%0D",
+	)
+)
+
+// ParseMarkup parses eve's html like markup (used in descriptions, titles, mail and bios) into a tree
+func ParseMarkup(input string) *MarkupNode {
+	root := &MarkupNode{Type: MarkupDocument}
+	current := root
+
+	// Some older descriptions come back as python unicode literals, ex: u'text'
+	if len(input) > 3 && strings.HasPrefix(input, "u'") && strings.HasSuffix(input, "'") {
+		input = input[2 : len(input)-1]
+	}
+
+	for len(input) > 0 {
+		start := strings.IndexByte(input, '<')
+		if start < 0 {
+			current.appendText(input)
+			break
+		}
+
+		if start > 0 {
+			current.appendText(input[:start])
+		}
+
+		end := strings.IndexByte(input[start:], '>')
+		if end < 0 {
+			current.appendText(input[start:])
+			break
+		}
+
+		tag := strings.TrimSpace(input[start+1 : start+end])
+		closing := strings.HasPrefix(tag, "/")
+		selfClosing := strings.HasSuffix(tag, "/")
+		tag = strings.TrimSuffix(strings.TrimPrefix(tag, "/"), "/")
+
+		name, attributes := parseMarkupTag(tag)
+		if name == "" {
+			// Not a tag, ex: "1 < 2", so keep the < as text and carry on after it
+			current.appendText("<")
+			input = input[start+1:]
+			continue
+		}
+
+		input = input[start+end+1:]
+		if closing {
+			current = closeMarkupTag(current, name)
+			continue
+		}
+
+		if name == "br" {
+			current.append(&MarkupNode{Type: MarkupBreak, Tag: name})
+			continue
+		}
+
+		node := &MarkupNode{
+			Type:       MarkupElement,
+			Tag:        name,
+			Attributes: attributes,
+		}
+
+		if name == "a" {
+			link := ParseMarkupLink(attributes["href"])
+			node.Link = &link
+		}
+
+		current.append(node)
+		if !selfClosing && !markupVoidTags[name] {
+			current = node
+		}
+	}
+
+	return root
+}
+
+// ParseMarkupLink parses the href of a markup link into a typed reference
+func ParseMarkupLink(href string) MarkupLink {
+	link := MarkupLink{Kind: LinkUnknown, Href: href}
+
+	switch {
+	case strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://"):
+		link.Kind = LinkURL
+	case strings.HasPrefix(href, "showinfo:"):
+		parts := strings.SplitN(strings.TrimPrefix(href, "showinfo:"), "//", 2)
+		typeID, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			return link
+		}
+
+		link.TypeID = uint32(typeID)
+		link.Kind = LinkType
+
+		if len(parts) == 2 {
+			itemID, err := strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				return link
+			}
+
+			link.ItemID = itemID
+			link.Kind = showInfoKind(link.TypeID, itemID)
+		}
+	case strings.HasPrefix(href, "killReport:"):
+		parts := strings.SplitN(strings.TrimPrefix(href, "killReport:"), ":", 2)
+		killID, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			return link
+		}
+
+		link.Kind = LinkKillMail
+		link.KillMailID = uint32(killID)
+		if len(parts) == 2 {
+			link.KillMailHash = parts[1]
+		}
+	}
+
+	return link
+}
+
+func showInfoKind(typeID uint32, itemID uint64) LinkKind {
+	switch {
+	case (typeID >= 1373 && typeID <= 1386) || typeID == 34574:
+		return LinkCharacter
+	case typeID == showInfoCorporation:
+		return LinkCorporation
+	case typeID == showInfoAlliance:
+		return LinkAlliance
+	case typeID == showInfoFaction:
+		return LinkFaction
+	case typeID == showInfoSolarSystem:
+		return LinkSolarSystem
+	case typeID == showInfoConstellation:
+		return LinkConstellation
+	case typeID == showInfoRegion:
+		return LinkRegion
+	case itemID >= 60000000 && itemID < 64000000:
+		return LinkStation
+	}
+
+	return LinkItem
+}
+
+func parseMarkupTag(tag string) (string, map[string]string) {
+	nameEnd := strings.IndexAny(tag, " \t\r\n=")
+	name := tag
+	rest := ""
+	if nameEnd >= 0 {
+		name = tag[:nameEnd]
+		rest = tag[nameEnd:]
+	}
+
+	name = strings.ToLower(name)
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		return "", nil
+	}
+
+	for _, char := range name {
+		if (char < 'a' || char > 'z') && (char < '0' || char > '9') {
+			return "", nil
+		}
+	}
+
+	attributes := map[string]string{}
+	for _, match := range markupAttribute.FindAllStringSubmatch(rest, -1) {
+		value := match[2]
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') {
+			value = value[1 : len(value)-1]
+		}
+
+		attributes[strings.ToLower(match[1])] = html.UnescapeString(value)
+	}
+
+	return name, attributes
+}
+
+func closeMarkupTag(current *MarkupNode, name string) *MarkupNode {
+	// Only close if the tag is actually open, stray closing tags are common in eve markup
+	for node := current; node != nil && node.Type != MarkupDocument; node = node.parent {
+		if node.Tag == name {
+			return node.parent
+		}
+	}
+
+	return current
+}
+
+func (node *MarkupNode) append(child *MarkupNode) {
+	child.parent = node
+	node.Children = append(node.Children, child)
+}
+
+func (node *MarkupNode) appendText(text string) {
+	node.append(&MarkupNode{Type: MarkupText, Text: html.UnescapeString(text)})
+}
+
+// PlainText renders the tree as plain text
+func (node *MarkupNode) PlainText() string {
+	var builder strings.Builder
+	node.renderText(&builder)
+	return strings.TrimSpace(builder.String())
+}
+
+func (node *MarkupNode) renderText(builder *strings.Builder) {
+	if markupHiddenTags[node.Tag] {
+		return
+	}
+
+	switch node.Type {
+	case MarkupText:
+		builder.WriteString(node.Text)
+		return
+	case MarkupBreak:
+		builder.WriteString("\n")
+		return
+	}
+
+	for _, child := range node.Children {
+		child.renderText(builder)
+	}
+
+	if markupBlockTags[node.Tag] {
+		builder.WriteString("\n")
+	}
+}
+
+// Markdown renders the tree as markdown, links are rendered with the url from resolve (nil only keeps web links)
+func (node *MarkupNode) Markdown(resolve LinkResolver) string {
+	var builder strings.Builder
+	node.renderMarkdown(&builder, resolve)
+	return strings.TrimSpace(builder.String())
+}
+
+func (node *MarkupNode) renderMarkdown(builder *strings.Builder, resolve LinkResolver) {
+	if markupHiddenTags[node.Tag] {
+		return
+	}
+
+	switch node.Type {
+	case MarkupText:
+		builder.WriteString(escapeMarkdown(node.Text))
+		return
+	case MarkupBreak:
+		builder.WriteString("  \n")
+		return
+	}
+
+	var inner strings.Builder
+	for _, child := range node.Children {
+		child.renderMarkdown(&inner, resolve)
+	}
+
+	content := inner.String()
+	if strings.TrimSpace(content) == "" {
+		builder.WriteString(content)
+		return
+	}
+
+	switch node.Tag {
+	case "b":
+		fmt.Fprintf(builder, "**%s**", content)
+	case "i":
+		fmt.Fprintf(builder, "*%s*", content)
+	case "a":
+		if url := resolveLink(node.Link, resolve); url != "" {
+			fmt.Fprintf(builder, "[%s](%s)", content, url)
+		} else {
+			builder.WriteString(content)
+		}
+	default:
+		builder.WriteString(content)
+		if markupBlockTags[node.Tag] {
+			builder.WriteString("\n\n")
+		}
+	}
+}
+
+// HTML renders the tree as html that is safe to embed in a page, only formatting, colors and links are kept
+func (node *MarkupNode) HTML(resolve LinkResolver) string {
+	var builder strings.Builder
+	node.renderHTML(&builder, resolve)
+	return builder.String()
+}
+
+func (node *MarkupNode) renderHTML(builder *strings.Builder, resolve LinkResolver) {
+	if markupHiddenTags[node.Tag] {
+		return
+	}
+
+	switch node.Type {
+	case MarkupText:
+		builder.WriteString(html.EscapeString(node.Text))
+		return
+	case MarkupBreak:
+		builder.WriteString("<br>")
+		return
+	}
+
+	open, close := "", ""
+	switch {
+	case markupSafeTags[node.Tag]:
+		open, close = "<"+node.Tag+">", "</"+node.Tag+">"
+	case node.Tag == "font":
+		if color := markupCSSColor(node.Attributes["color"]); color != "" {
+			open, close = fmt.Sprintf(`<span style="color:%s">`, color), "</span>"
+		}
+	case node.Tag == "a":
+		if url := resolveLink(node.Link, resolve); url != "" {
+			open, close = fmt.Sprintf(`<a href="%s" rel="nofollow noopener">`, html.EscapeString(url)), "</a>"
+		}
+	}
+
+	builder.WriteString(open)
+	for _, child := range node.Children {
+		child.renderHTML(builder, resolve)
+	}
+	builder.WriteString(close)
+}
+
+// Links gets every link in the tree in document order
+func (node *MarkupNode) Links() []MarkupLink {
+	var links []MarkupLink
+	if node.Link != nil {
+		links = append(links, *node.Link)
+	}
+
+	for _, child := range node.Children {
+		links = append(links, child.Links()...)
+	}
+
+	return links
+}
+
+func resolveLink(link *MarkupLink, resolve LinkResolver) string {
+	if link == nil {
+		return ""
+	}
+
+	target := ""
+	if resolve != nil {
+		target = resolve(*link)
+	} else if link.Kind == LinkURL {
+		target = link.Href
+	}
+
+	return safeLinkURL(target)
+}
+
+// safeLinkURL only lets through web and relative urls, with the characters that could break out of a link target escaped
+func safeLinkURL(target string) string {
+	parsed, err := url.Parse(strings.TrimSpace(target))
+	if err != nil || (parsed.Scheme != "" && parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ""
+	}
+
+	return markupURLEscaper.Replace(parsed.String())
+}
+
+// markupCSSColor converts eve's #AARRGGBB colors into css #RRGGBB colors
+func markupCSSColor(color string) string {
+	match := markupColor.FindStringSubmatch(color)
+	if match == nil {
+		return ""
+	}
+
+	return "#" + strings.ToLower(match[2])
+}
+
+// escapeMarkdown escapes markdown syntax, and encodes <, > and & so renderers don't pass text through as raw html
+func escapeMarkdown(text string) string {
+	replacer := strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		`\`, `\\`,
+		"*", `\*`,
+		"_", `\_`,
+		"[", `\[`,
+		"]", `\]`,
+		"`", "\\`",
+	)
+
+	return replacer.Replace(text)
+}
+
+// DescriptionMarkup parses the characters bio
+func (character CharacterDetails) DescriptionMarkup() *MarkupNode {
+	return ParseMarkup(character.Description)
+}
+
+// NameMarkup parses the title name, titles are often colored or formatted
+func (title Title) NameMarkup() *MarkupNode {
+	return ParseMarkup(title.Name)
+}
+
+// DescriptionMarkup parses the region description
+func (region Region) DescriptionMarkup() *MarkupNode {
+	return ParseMarkup(region.Description)
+}
+
+// DescriptionMarkup parses the type description
+func (universeType UniverseType) DescriptionMarkup() *MarkupNode {
+	return ParseMarkup(universeType.Description)
+}
+
+// BodyMarkup parses the mail body
+func (mail Mail) BodyMarkup() *MarkupNode {
+	return ParseMarkup(mail.Body)
+}
@@ -0,0 +1,99 @@
+package esi
+
+import "testing"
+
+func TestMarkupHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"javascript href", `<a href="javascript:alert(1)">x</a>`, `x`},
+		{"mixed case javascript href", `<a href="JavaScript:alert(1)">x</a>`, `x`},
+		{"quotes in href", `<a href='https://x.com/"onmouseover="alert(1)'>x</a>`, `<a href="https://x.com/%22onmouseover=%22alert%281%29" rel="nofollow noopener">x</a>`},
+		{"script body", `a<script>alert("x")</script>b`, `ab`},
+		{"style body", `<style>body{display:none}</style>a`, `a`},
+		{"escaped tag text", `&lt;img src=x onerror=alert(1)&gt;`, `&lt;img src=x onerror=alert(1)&gt;`},
+		{"event attribute", `<b onclick="alert(1)">b</b>`, `<b>b</b>`},
+		{"unsafe tag", `<img src=x onerror=alert(1)>a`, `a`},
+		{"css injection in color", `<font color="red;background:url(x)">a</font>`, `a`},
+		{"argb color", `<font color="#ff00ff00">a</font>`, `<span style="color:#00ff00">a</span>`},
+		{"showinfo link without resolver", `<a href="showinfo:1377//90000001">Bob</a>`, `Bob`},
+		{"line break", `a<br>b`, `a<br>b`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := ParseMarkup(test.input).HTML(nil); actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMarkupMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"link breaking out of its target", `<a href="https://x.com/a)[y](javascript:alert(1)">z</a>`, `[z](https://x.com/a%29[y]%28javascript:alert%281%29)`},
+		{"javascript href", `<a href="javascript:alert(1)">x</a>`, `x`},
+		{"raw html in text", `&lt;img src=x onerror=alert(1)&gt;`, `&lt;img src=x onerror=alert(1)&gt;`},
+		{"markdown syntax in text", `*not* [a](link)`, `\*not\* \[a\](link)`},
+		{"script body", `a<script>alert(1)</script>b`, `ab`},
+		{"formatting", `<b>bold</b> <i>italic</i>`, `**bold** *italic*`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := ParseMarkup(test.input).Markdown(nil); actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMarkupResolver(t *testing.T) {
+	resolve := func(link MarkupLink) string {
+		switch link.Kind {
+		case LinkCharacter:
+			return "/characters/90000001 (main)"
+		case LinkKillMail:
+			return "javascript:alert(1)"
+		}
+
+		return ""
+	}
+
+	node := ParseMarkup(`<a href="showinfo:1377//90000001">Bob</a> <a href="killReport:123:abc">kill</a>`)
+
+	if actual, expected := node.HTML(resolve), `<a href="/characters/90000001%20%28main%29" rel="nofollow noopener">Bob</a> kill`; actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+
+	if actual, expected := node.Markdown(resolve), `[Bob](/characters/90000001%20%28main%29) kill`; actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestMarkupPlainText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"breaks and entities", `a<br>b &amp; c`, "a\nb & c"},
+		{"stray less than", `1<2`, `1<2`},
+		{"python literal", `u'hello'`, `hello`},
+		{"paragraphs", `<p>a</p><p>b</p>`, "a\nb"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := ParseMarkup(test.input).PlainText(); actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
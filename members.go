@@ -0,0 +1,411 @@
+package esi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MemberTracking is when a corporation member last logged in and where they are
+type MemberTracking struct {
+	BaseID      uint32     `json:"base_id,omitempty"`
+	CharacterID uint32     `json:"character_id"`
+	LocationID  int64      `json:"location_id,omitempty"`
+	LogoffDate  *time.Time `json:"logoff_date,omitempty"`
+	LogonDate   *time.Time `json:"logon_date,omitempty"`
+	ShipTypeID  uint32     `json:"ship_type_id,omitempty"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+}
+
+// MemberRoles is the roles, and roles they can grant to others, a corporation member has
+type MemberRoles struct {
+	CharacterID           uint32   `json:"character_id"`
+	GrantableRoles        []string `json:"grantable_roles,omitempty"`
+	GrantableRolesAtBase  []string `json:"grantable_roles_at_base,omitempty"`
+	GrantableRolesAtHQ    []string `json:"grantable_roles_at_hq,omitempty"`
+	GrantableRolesAtOther []string `json:"grantable_roles_at_other,omitempty"`
+	Roles                 []string `json:"roles,omitempty"`
+	RolesAtBase           []string `json:"roles_at_base,omitempty"`
+	RolesAtHQ             []string `json:"roles_at_hq,omitempty"`
+	RolesAtOther          []string `json:"roles_at_other,omitempty"`
+}
+
+// MemberTitles is the ids of the titles a corporation member holds
+type MemberTitles struct {
+	CharacterID uint32   `json:"character_id"`
+	Titles      []uint32 `json:"titles,omitempty"`
+}
+
+// CorporationTitle is a title in the corporation and the roles it grants
+type CorporationTitle struct {
+	ID           uint32   `json:"title_id"`
+	Name         string   `json:"name,omitempty"`
+	Roles        []string `json:"roles,omitempty"`
+	RolesAtBase  []string `json:"roles_at_base,omitempty"`
+	RolesAtHQ    []string `json:"roles_at_hq,omitempty"`
+	RolesAtOther []string `json:"roles_at_other,omitempty"`
+}
+
+// RoleChange is a change to a members roles
+type RoleChange struct {
+	ChangedAt   time.Time `json:"changed_at"`
+	CharacterID uint32    `json:"character_id"`
+	IssuerID    uint32    `json:"issuer_id"`
+	NewRoles    []string  `json:"new_roles"`
+	OldRoles    []string  `json:"old_roles"`
+	RoleType    string    `json:"role_type"`
+}
+
+// MemberAuditOptions are the checks a member audit runs
+type MemberAuditOptions struct {
+	// InactiveDays flags members that haven't logged in for at least this many days, 0 skips the check
+	InactiveDays int
+	// GrantedSince lists roles granted on or after this time, a zero time skips the check
+	GrantedSince time.Time
+	// SensitiveRoles are the director level roles that should only be held through a title, defaults to Director
+	SensitiveRoles []CorporationRole
+}
+
+// InactiveMember is a member that hasn't logged in recently, LastSeen is zero if they never have
+type InactiveMember struct {
+	CharacterID uint32
+	LastSeen    time.Time
+	Days        int
+}
+
+// RoleGrant is a role that was given to a member
+type RoleGrant struct {
+	CharacterID uint32
+	IssuerID    uint32
+	Role        CorporationRole
+	Scope       RoleScope
+	Grantable   bool
+	GrantedAt   time.Time
+}
+
+// UntitledRole is a sensitive role a member holds that none of their titles grant
+type UntitledRole struct {
+	CharacterID uint32
+	Role        CorporationRole
+	Scope       RoleScope
+}
+
+// MemberAudit is a report on the corporations members
+type MemberAudit struct {
+	Taken       time.Time
+	MemberCount int
+	MemberLimit int32
+	Inactive    []InactiveMember
+	Granted     []RoleGrant
+	Untitled    []UntitledRole
+}
+
+// GetCorporationMembers gets the ids of every member of the corporation
+func (esi Client) GetCorporationMembers(corporationID uint32, token string) ([]uint32, error) {
+	var members []uint32
+	err := esi.authGet(fmt.Sprintf("/v4/corporations/%d/members/", corporationID), token, &members)
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// GetCorporationMemberLimit gets the maximum number of members the corporation can have
+func (esi Client) GetCorporationMemberLimit(corporationID uint32, token string) (int32, error) {
+	var limit int32
+	err := esi.authGet(fmt.Sprintf("/v2/corporations/%d/members/limit/", corporationID), token, &limit)
+	if err != nil {
+		return 0, err
+	}
+
+	return limit, nil
+}
+
+// GetCorporationMemberTracking gets when each member last logged in and where they are
+func (esi Client) GetCorporationMemberTracking(corporationID uint32, token string) ([]MemberTracking, error) {
+	var tracking []MemberTracking
+	err := esi.authGet(fmt.Sprintf("/v2/corporations/%d/membertracking/", corporationID), token, &tracking)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracking, nil
+}
+
+// GetCorporationMemberRoles gets the roles of every member
+func (esi Client) GetCorporationMemberRoles(corporationID uint32, token string) ([]MemberRoles, error) {
+	var roles []MemberRoles
+	err := esi.authGet(fmt.Sprintf("/v2/corporations/%d/roles/", corporationID), token, &roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// GetCorporationMemberTitles gets the titles of every member
+func (esi Client) GetCorporationMemberTitles(corporationID uint32, token string) ([]MemberTitles, error) {
+	var titles []MemberTitles
+	err := esi.authGet(fmt.Sprintf("/v2/corporations/%d/members/titles/", corporationID), token, &titles)
+	if err != nil {
+		return nil, err
+	}
+
+	return titles, nil
+}
+
+// GetCorporationTitles gets the corporations titles and the roles they grant
+func (esi Client) GetCorporationTitles(corporationID uint32, token string) ([]CorporationTitle, error) {
+	var titles []CorporationTitle
+	err := esi.authGet(fmt.Sprintf("/v2/corporations/%d/titles/", corporationID), token, &titles)
+	if err != nil {
+		return nil, err
+	}
+
+	return titles, nil
+}
+
+// GetCorporationRoleHistory gets every page of changes to members roles over the last month
+func (esi Client) GetCorporationRoleHistory(corporationID uint32, token string) ([]RoleChange, error) {
+	return getAllPages[RoleChange](esi, fmt.Sprintf("/v2/corporations/%d/roles/history/", corporationID), token)
+}
+
+// Parse converts the members role names into typed role sets
+func (member MemberRoles) Parse() CorporationRoles {
+	return Roles{
+		Roles:      member.Roles,
+		BaseRoles:  member.RolesAtBase,
+		HQRoles:    member.RolesAtHQ,
+		OtherRoles: member.RolesAtOther,
+	}.Parse()
+}
+
+// Parse converts the role names the title grants into typed role sets
+func (title CorporationTitle) Parse() CorporationRoles {
+	return Roles{
+		Roles:      title.Roles,
+		BaseRoles:  title.RolesAtBase,
+		HQRoles:    title.RolesAtHQ,
+		OtherRoles: title.RolesAtOther,
+	}.Parse()
+}
+
+// Scope gets the scope the change applies to and if it changed grantable roles
+func (change RoleChange) Scope() (RoleScope, bool) {
+	grantable := strings.HasPrefix(change.RoleType, "grantable_")
+
+	switch strings.TrimPrefix(change.RoleType, "grantable_") {
+	case "roles_at_base":
+		return ScopeBase, grantable
+	case "roles_at_hq":
+		return ScopeHQ, grantable
+	case "roles_at_other":
+		return ScopeOther, grantable
+	}
+
+	return ScopeGlobal, grantable
+}
+
+// Added gets the roles that were granted in the change
+func (change RoleChange) Added() []CorporationRole {
+	old := createRoleSet(change.OldRoles)
+	added := RoleSet{}
+	for role := range createRoleSet(change.NewRoles) {
+		if !old.Has(role) {
+			added[role] = true
+		}
+	}
+
+	return added.Sorted()
+}
+
+// GetMemberAudit gets the corporations members, tracking, roles, titles and role history and audits them
+func (esi Client) GetMemberAudit(corporationID uint32, options MemberAuditOptions, token string) (*MemberAudit, error) {
+	members, err := esi.GetCorporationMembers(corporationID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := esi.GetCorporationMemberLimit(corporationID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	tracking, err := esi.GetCorporationMemberTracking(corporationID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := esi.GetCorporationMemberRoles(corporationID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	memberTitles, err := esi.GetCorporationMemberTitles(corporationID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	titles, err := esi.GetCorporationTitles(corporationID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []RoleChange
+	if !options.GrantedSince.IsZero() {
+		history, err = esi.GetCorporationRoleHistory(corporationID, token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	audit := AuditMembers(members, tracking, roles, memberTitles, titles, history, options, time.Now())
+	audit.MemberLimit = limit
+	return audit, nil
+}
+
+// AuditMembers joins the member data to flag inactive members, recently granted roles and sensitive roles held without a title
+func AuditMembers(
+	members []uint32,
+	tracking []MemberTracking,
+	roles []MemberRoles,
+	memberTitles []MemberTitles,
+	titles []CorporationTitle,
+	history []RoleChange,
+	options MemberAuditOptions,
+	now time.Time,
+) *MemberAudit {
+	audit := &MemberAudit{
+		Taken:       now,
+		MemberCount: len(members),
+	}
+
+	if options.InactiveDays > 0 {
+		audit.Inactive = findInactiveMembers(members, tracking, options.InactiveDays, now)
+	}
+
+	if !options.GrantedSince.IsZero() {
+		audit.Granted = findRoleGrants(history, options.GrantedSince)
+	}
+
+	sensitive := options.SensitiveRoles
+	if len(sensitive) == 0 {
+		sensitive = []CorporationRole{RoleDirector}
+	}
+
+	audit.Untitled = findUntitledRoles(roles, memberTitles, titles, sensitive)
+	return audit
+}
+
+func findInactiveMembers(members []uint32, tracking []MemberTracking, days int, now time.Time) []InactiveMember {
+	lastSeen := map[uint32]time.Time{}
+	for _, entry := range tracking {
+		var seen time.Time
+		for _, date := range []*time.Time{entry.LogonDate, entry.LogoffDate} {
+			if date != nil && date.After(seen) {
+				seen = *date
+			}
+		}
+
+		lastSeen[entry.CharacterID] = seen
+	}
+
+	cutoff := now.Add(-time.Duration(days) * 24 * time.Hour)
+	inactive := []InactiveMember{}
+	for _, characterID := range members {
+		seen := lastSeen[characterID]
+		if seen.After(cutoff) {
+			continue
+		}
+
+		member := InactiveMember{CharacterID: characterID, LastSeen: seen}
+		if !seen.IsZero() {
+			member.Days = int(now.Sub(seen).Hours() / 24)
+		}
+
+		inactive = append(inactive, member)
+	}
+
+	sort.Slice(inactive, func(i, j int) bool {
+		if !inactive[i].LastSeen.Equal(inactive[j].LastSeen) {
+			return inactive[i].LastSeen.Before(inactive[j].LastSeen)
+		}
+
+		return inactive[i].CharacterID < inactive[j].CharacterID
+	})
+
+	return inactive
+}
+
+func findRoleGrants(history []RoleChange, since time.Time) []RoleGrant {
+	grants := []RoleGrant{}
+	for _, change := range history {
+		if change.ChangedAt.Before(since) {
+			continue
+		}
+
+		scope, grantable := change.Scope()
+		for _, role := range change.Added() {
+			grants = append(grants, RoleGrant{
+				CharacterID: change.CharacterID,
+				IssuerID:    change.IssuerID,
+				Role:        role,
+				Scope:       scope,
+				Grantable:   grantable,
+				GrantedAt:   change.ChangedAt,
+			})
+		}
+	}
+
+	sort.SliceStable(grants, func(i, j int) bool {
+		return grants[i].GrantedAt.After(grants[j].GrantedAt)
+	})
+
+	return grants
+}
+
+func findUntitledRoles(roles []MemberRoles, memberTitles []MemberTitles, titles []CorporationTitle, sensitive []CorporationRole) []UntitledRole {
+	titleRoles := map[uint32]CorporationRoles{}
+	for _, title := range titles {
+		titleRoles[title.ID] = title.Parse()
+	}
+
+	held := map[uint32][]uint32{}
+	for _, member := range memberTitles {
+		held[member.CharacterID] = member.Titles
+	}
+
+	scopes := []RoleScope{ScopeGlobal, ScopeBase, ScopeHQ, ScopeOther}
+	untitled := []UntitledRole{}
+	for _, member := range roles {
+		granted := member.Parse()
+
+		for _, role := range sensitive {
+			for _, scope := range scopes {
+				if !granted.Scope(scope).Has(role) {
+					continue
+				}
+
+				matched := false
+				for _, titleID := range held[member.CharacterID] {
+					if title, ok := titleRoles[titleID]; ok && title.Scope(scope).Has(role) {
+						matched = true
+						break
+					}
+				}
+
+				if !matched {
+					untitled = append(untitled, UntitledRole{CharacterID: member.CharacterID, Role: role, Scope: scope})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(untitled, func(i, j int) bool {
+		return untitled[i].CharacterID < untitled[j].CharacterID
+	})
+
+	return untitled
+}
@@ -0,0 +1,174 @@
+package esi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Date is a calendar day returned by esi in the YYYY-MM-DD format
+type Date struct {
+	time.Time
+}
+
+// UnmarshalJSON parses a YYYY-MM-DD date
+func (date *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return err
+	}
+
+	date.Time = parsed
+	return nil
+}
+
+// MarshalJSON formats the date as YYYY-MM-DD
+func (date Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(date.Format("2006-01-02"))
+}
+
+// MiningEntry is the amount of ore the character mined of a type in a system on a day
+type MiningEntry struct {
+	Date          Date   `json:"date"`
+	Quantity      int64  `json:"quantity"`
+	SolarSystemID uint32 `json:"solar_system_id"`
+	TypeID        uint32 `json:"type_id"`
+}
+
+// MiningObserver is a structure that records the ore mined around it, ex: a refinery
+type MiningObserver struct {
+	LastUpdated  Date   `json:"last_updated"`
+	ObserverID   int64  `json:"observer_id"`
+	ObserverType string `json:"observer_type"`
+}
+
+// ObservedMining is the amount of ore a character mined of a type at an observer on a day
+type ObservedMining struct {
+	CharacterID           uint32 `json:"character_id"`
+	LastUpdated           Date   `json:"last_updated"`
+	Quantity              int64  `json:"quantity"`
+	RecordedCorporationID uint32 `json:"recorded_corporation_id"`
+	TypeID                uint32 `json:"type_id"`
+}
+
+// MoonExtractionTimer is a scheduled moon extraction at one of the corporations refineries
+type MoonExtractionTimer struct {
+	ChunkArrivalTime    time.Time `json:"chunk_arrival_time"`
+	ExtractionStartTime time.Time `json:"extraction_start_time"`
+	MoonID              uint32    `json:"moon_id"`
+	NaturalDecayTime    time.Time `json:"natural_decay_time"`
+	StructureID         int64     `json:"structure_id"`
+}
+
+// MiningTotal is the total ore of a type a character mined in a system
+type MiningTotal struct {
+	CharacterID   uint32
+	TypeID        uint32
+	SolarSystemID uint32
+	Quantity      int64
+}
+
+// GetCharacterMining gets every page of the characters mining ledger for the last 30 days
+func (esi Client) GetCharacterMining(characterID uint32, token string) ([]MiningEntry, error) {
+	return getAllPages[MiningEntry](esi, fmt.Sprintf("/v1/characters/%d/mining/", characterID), token)
+}
+
+// GetMiningObservers gets every page of the corporations mining observers
+func (esi Client) GetMiningObservers(corporationID uint32, token string) ([]MiningObserver, error) {
+	return getAllPages[MiningObserver](esi, fmt.Sprintf("/v1/corporation/%d/mining/observers/", corporationID), token)
+}
+
+// GetObservedMining gets every page of the ore recorded by one of the corporations mining observers
+func (esi Client) GetObservedMining(corporationID uint32, observerID int64, token string) ([]ObservedMining, error) {
+	return getAllPages[ObservedMining](esi, fmt.Sprintf("/v1/corporation/%d/mining/observers/%d/", corporationID, observerID), token)
+}
+
+// GetMoonExtractions gets every page of the corporations scheduled moon extractions
+func (esi Client) GetMoonExtractions(corporationID uint32, token string) ([]MoonExtractionTimer, error) {
+	return getAllPages[MoonExtractionTimer](esi, fmt.Sprintf("/v1/corporation/%d/mining/extractions/", corporationID), token)
+}
+
+type miningKey struct {
+	character uint32
+	typeID    uint32
+	system    uint32
+}
+
+// AggregateCharacterMining totals a characters mining ledger by type and system for days between from and to (inclusive)
+func AggregateCharacterMining(characterID uint32, entries []MiningEntry, from time.Time, to time.Time) []MiningTotal {
+	totals := map[miningKey]int64{}
+	for _, entry := range entries {
+		if inDateRange(entry.Date, from, to) {
+			totals[miningKey{characterID, entry.TypeID, entry.SolarSystemID}] += entry.Quantity
+		}
+	}
+
+	return sortMiningTotals(totals)
+}
+
+// AggregateObservedMining totals what an observer recorded by character and type for days between from and to (inclusive).
+// Observers don't report their system, so it needs to be passed in (ex: from GetStructure).
+func AggregateObservedMining(entries []ObservedMining, systemID uint32, from time.Time, to time.Time) []MiningTotal {
+	totals := map[miningKey]int64{}
+	for _, entry := range entries {
+		if inDateRange(entry.LastUpdated, from, to) {
+			totals[miningKey{entry.CharacterID, entry.TypeID, systemID}] += entry.Quantity
+		}
+	}
+
+	return sortMiningTotals(totals)
+}
+
+// MergeMiningTotals combines totals from multiple characters or observers into a single set of totals
+func MergeMiningTotals(groups ...[]MiningTotal) []MiningTotal {
+	totals := map[miningKey]int64{}
+	for _, group := range groups {
+		for _, total := range group {
+			totals[miningKey{total.CharacterID, total.TypeID, total.SolarSystemID}] += total.Quantity
+		}
+	}
+
+	return sortMiningTotals(totals)
+}
+
+func inDateRange(date Date, from time.Time, to time.Time) bool {
+	day := utcDay(date.Time)
+	return !day.Before(utcDay(from)) && !day.After(utcDay(to))
+}
+
+// utcDay gets the calendar day of the time (in its own time zone) as midnight utc, which is how esi dates are parsed
+func utcDay(value time.Time) time.Time {
+	year, month, day := value.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func sortMiningTotals(totals map[miningKey]int64) []MiningTotal {
+	results := make([]MiningTotal, 0, len(totals))
+	for key, quantity := range totals {
+		results = append(results, MiningTotal{
+			CharacterID:   key.character,
+			TypeID:        key.typeID,
+			SolarSystemID: key.system,
+			Quantity:      quantity,
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].CharacterID != results[j].CharacterID {
+			return results[i].CharacterID < results[j].CharacterID
+		}
+		if results[i].SolarSystemID != results[j].SolarSystemID {
+			return results[i].SolarSystemID < results[j].SolarSystemID
+		}
+
+		return results[i].TypeID < results[j].TypeID
+	})
+
+	return results
+}
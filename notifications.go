@@ -0,0 +1,276 @@
+package esi
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// NotificationType is the type of notification that was sent to the character
+type NotificationType string
+
+const (
+	NotificationCharAppAccept                 NotificationType = "CharAppAcceptMsg"
+	NotificationCharLeftCorp                  NotificationType = "CharLeftCorpMsg"
+	NotificationCorpAppNew                    NotificationType = "CorpAppNewMsg"
+	NotificationEntosisCaptureStarted         NotificationType = "EntosisCaptureStarted"
+	NotificationKillReportFinalBlow           NotificationType = "KillReportFinalBlow"
+	NotificationKillReportVictim              NotificationType = "KillReportVictim"
+	NotificationMoonminingAutomaticFracture   NotificationType = "MoonminingAutomaticFracture"
+	NotificationMoonminingExtractionCancelled NotificationType = "MoonminingExtractionCancelled"
+	NotificationMoonminingExtractionFinished  NotificationType = "MoonminingExtractionFinished"
+	NotificationMoonminingExtractionStarted   NotificationType = "MoonminingExtractionStarted"
+	NotificationMoonminingLaserFired          NotificationType = "MoonminingLaserFired"
+	NotificationOrbitalAttacked               NotificationType = "OrbitalAttacked"
+	NotificationSovStructureReinforced        NotificationType = "SovStructureReinforced"
+	NotificationStructureAnchoring            NotificationType = "StructureAnchoring"
+	NotificationStructureDestroyed            NotificationType = "StructureDestroyed"
+	NotificationStructureFuelAlert            NotificationType = "StructureFuelAlert"
+	NotificationStructureLostArmor            NotificationType = "StructureLostArmor"
+	NotificationStructureLostShields          NotificationType = "StructureLostShields"
+	NotificationStructureServicesOffline      NotificationType = "StructureServicesOffline"
+	NotificationStructureUnderAttack          NotificationType = "StructureUnderAttack"
+	NotificationTowerAlert                    NotificationType = "TowerAlertMsg"
+	NotificationWarDeclared                   NotificationType = "WarDeclared"
+)
+
+// Notification is a notification sent to the character, the Text is a yaml document that depends on the Type
+type Notification struct {
+	IsRead         bool             `json:"is_read,omitempty"`
+	NotificationID int64            `json:"notification_id"`
+	SenderID       int32            `json:"sender_id"`
+	SenderType     string           `json:"sender_type"`
+	Text           string           `json:"text,omitempty"`
+	Timestamp      time.Time        `json:"timestamp"`
+	Type           NotificationType `json:"type"`
+}
+
+// FileTime is a windows file time (100ns intervals since 1601) which is how eve stores dates in notifications
+type FileTime int64
+
+// fileTimeEpoch is the number of 100ns intervals between 1601-01-01 and 1970-01-01
+const fileTimeEpoch = 116444736000000000
+
+// Time converts the file time into a time.Time
+func (value FileTime) Time() time.Time {
+	if value == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, (int64(value)-fileTimeEpoch)*100).UTC()
+}
+
+// FileDuration is a duration in 100ns intervals which is how eve stores timers in notifications
+type FileDuration int64
+
+// Duration converts the file duration into a time.Duration
+func (value FileDuration) Duration() time.Duration {
+	return time.Duration(value) * 100
+}
+
+// StructureUnderAttack is sent when an upwell structure starts taking damage
+type StructureUnderAttack struct {
+	AllianceID       uint32        `yaml:"allianceID"`
+	AllianceName     string        `yaml:"allianceName"`
+	ArmorPercentage  float64       `yaml:"armorPercentage"`
+	CharacterID      uint32        `yaml:"charID"`
+	CorporationName  string        `yaml:"corpName"`
+	HullPercentage   float64       `yaml:"hullPercentage"`
+	ShieldPercentage float64       `yaml:"shieldPercentage"`
+	SolarSystemID    uint32        `yaml:"solarsystemID"`
+	StructureID      uint64        `yaml:"structureID"`
+	StructureTypeID  uint32        `yaml:"structureTypeID"`
+	ShowInfo         []interface{} `yaml:"structureShowInfoData"`
+}
+
+// StructureReinforced is sent when an upwell structure loses its shields or armor and enters a reinforcement timer
+type StructureReinforced struct {
+	SolarSystemID   uint32        `yaml:"solarsystemID"`
+	StructureID     uint64        `yaml:"structureID"`
+	StructureTypeID uint32        `yaml:"structureTypeID"`
+	TimeLeft        FileDuration  `yaml:"timeLeft"`
+	Timestamp       FileTime      `yaml:"timestamp"`
+	VulnerableTime  FileDuration  `yaml:"vulnerableTime"`
+	ShowInfo        []interface{} `yaml:"structureShowInfoData"`
+}
+
+// ExitsReinforcement gets when the structure comes out of reinforcement
+func (notification StructureReinforced) ExitsReinforcement() time.Time {
+	return notification.Timestamp.Time().Add(notification.TimeLeft.Duration())
+}
+
+// StructureDestroyed is sent when an upwell structure is destroyed
+type StructureDestroyed struct {
+	IsAbandoned     bool   `yaml:"isAbandoned"`
+	OwnerCorpName   string `yaml:"ownerCorpName"`
+	SolarSystemID   uint32 `yaml:"solarsystemID"`
+	StructureID     uint64 `yaml:"structureID"`
+	StructureTypeID uint32 `yaml:"structureTypeID"`
+}
+
+// StructureFuelAlert is sent when an upwell structure is running low on fuel
+type StructureFuelAlert struct {
+	Fuel            [][]int64 `yaml:"listOfTypesAndQty"`
+	SolarSystemID   uint32    `yaml:"solarsystemID"`
+	StructureID     uint64    `yaml:"structureID"`
+	StructureTypeID uint32    `yaml:"structureTypeID"`
+}
+
+// StructureServicesOffline is sent when an upwell structure runs out of fuel and takes its services offline
+type StructureServicesOffline struct {
+	Modules         []uint32 `yaml:"listOfServiceModuleIDs"`
+	SolarSystemID   uint32   `yaml:"solarsystemID"`
+	StructureID     uint64   `yaml:"structureID"`
+	StructureTypeID uint32   `yaml:"structureTypeID"`
+}
+
+// StructureAnchoring is sent when an upwell structure starts anchoring
+type StructureAnchoring struct {
+	OwnerCorpName   string       `yaml:"ownerCorpName"`
+	SolarSystemID   uint32       `yaml:"solarsystemID"`
+	StructureID     uint64       `yaml:"structureID"`
+	StructureTypeID uint32       `yaml:"structureTypeID"`
+	TimeLeft        FileDuration `yaml:"timeLeft"`
+	VulnerableTime  FileDuration `yaml:"vulnerableTime"`
+}
+
+// WarDeclared is sent when a war is declared against or by the characters corporation or alliance
+type WarDeclared struct {
+	AgainstID    uint32   `yaml:"againstID"`
+	Cost         float64  `yaml:"cost"`
+	DeclaredByID uint32   `yaml:"declaredByID"`
+	DelayHours   int      `yaml:"delayHours"`
+	HostileState bool     `yaml:"hostileState"`
+	TimeStarted  FileTime `yaml:"timeStarted"`
+	WarHQ        string   `yaml:"warHQ"`
+}
+
+// MoonExtraction is sent when a moon drill is started, finished, cancelled, fractured or fired
+type MoonExtraction struct {
+	AutoTime        FileTime           `yaml:"autoTime"`
+	ReadyTime       FileTime           `yaml:"readyTime"`
+	MoonID          uint32             `yaml:"moonID"`
+	OreVolumeByType map[uint32]float64 `yaml:"oreVolumeByType"`
+	SolarSystemID   uint32             `yaml:"solarSystemID"`
+	StartedBy       uint32             `yaml:"startedBy"`
+	CancelledBy     uint32             `yaml:"cancelledBy"`
+	FiredBy         uint32             `yaml:"firedBy"`
+	StructureID     uint64             `yaml:"structureID"`
+	StructureName   string             `yaml:"structureName"`
+	StructureTypeID uint32             `yaml:"structureTypeID"`
+}
+
+// SovStructureReinforced is sent when a sovereignty structure is reinforced by entosis
+type SovStructureReinforced struct {
+	CampaignEventType int      `yaml:"campaignEventType"`
+	DecloakTime       FileTime `yaml:"decloakTime"`
+	SolarSystemID     uint32   `yaml:"solarSystemID"`
+}
+
+// EntosisCaptureStarted is sent when someone starts an entosis link on a sovereignty structure
+type EntosisCaptureStarted struct {
+	SolarSystemID   uint32 `yaml:"solarSystemID"`
+	StructureTypeID uint32 `yaml:"structureTypeID"`
+}
+
+// TowerAlert is sent when a starbase or customs office is attacked
+type TowerAlert struct {
+	AggressorAllianceID uint32  `yaml:"aggressorAllianceID"`
+	AggressorCorpID     uint32  `yaml:"aggressorCorpID"`
+	AggressorID         uint32  `yaml:"aggressorID"`
+	ArmorValue          float64 `yaml:"armorValue"`
+	HullValue           float64 `yaml:"hullValue"`
+	MoonID              uint32  `yaml:"moonID"`
+	PlanetID            uint32  `yaml:"planetID"`
+	ShieldValue         float64 `yaml:"shieldValue"`
+	SolarSystemID       uint32  `yaml:"solarSystemID"`
+	TypeID              uint32  `yaml:"typeID"`
+}
+
+// CorporationMembership is sent when characters apply to, join or leave a corporation
+type CorporationMembership struct {
+	ApplicationText string `yaml:"applicationText"`
+	CharacterID     uint32 `yaml:"charID"`
+	CorporationID   uint32 `yaml:"corpID"`
+}
+
+// KillReport is sent to the victim and final blow of a kill, the id and hash can be passed to GetKillMail
+type KillReport struct {
+	KillMailHash     string `yaml:"killMailHash"`
+	KillMailID       uint32 `yaml:"killMailID"`
+	VictimShipTypeID uint32 `yaml:"victimShipTypeID"`
+}
+
+var (
+	notificationMutex sync.RWMutex
+	notificationTypes = map[NotificationType]func() interface{}{
+		NotificationCharAppAccept:                 func() interface{} { return &CorporationMembership{} },
+		NotificationCharLeftCorp:                  func() interface{} { return &CorporationMembership{} },
+		NotificationCorpAppNew:                    func() interface{} { return &CorporationMembership{} },
+		NotificationEntosisCaptureStarted:         func() interface{} { return &EntosisCaptureStarted{} },
+		NotificationKillReportFinalBlow:           func() interface{} { return &KillReport{} },
+		NotificationKillReportVictim:              func() interface{} { return &KillReport{} },
+		NotificationMoonminingAutomaticFracture:   func() interface{} { return &MoonExtraction{} },
+		NotificationMoonminingExtractionCancelled: func() interface{} { return &MoonExtraction{} },
+		NotificationMoonminingExtractionFinished:  func() interface{} { return &MoonExtraction{} },
+		NotificationMoonminingExtractionStarted:   func() interface{} { return &MoonExtraction{} },
+		NotificationMoonminingLaserFired:          func() interface{} { return &MoonExtraction{} },
+		NotificationOrbitalAttacked:               func() interface{} { return &TowerAlert{} },
+		NotificationSovStructureReinforced:        func() interface{} { return &SovStructureReinforced{} },
+		NotificationStructureAnchoring:            func() interface{} { return &StructureAnchoring{} },
+		NotificationStructureDestroyed:            func() interface{} { return &StructureDestroyed{} },
+		NotificationStructureFuelAlert:            func() interface{} { return &StructureFuelAlert{} },
+		NotificationStructureLostArmor:            func() interface{} { return &StructureReinforced{} },
+		NotificationStructureLostShields:          func() interface{} { return &StructureReinforced{} },
+		NotificationStructureServicesOffline:      func() interface{} { return &StructureServicesOffline{} },
+		NotificationStructureUnderAttack:          func() interface{} { return &StructureUnderAttack{} },
+		NotificationTowerAlert:                    func() interface{} { return &TowerAlert{} },
+		NotificationWarDeclared:                   func() interface{} { return &WarDeclared{} },
+	}
+)
+
+// RegisterNotification adds or replaces the struct used to parse the body of a notification type.
+// The factory needs to return a pointer that the yaml body can be decoded into.
+func RegisterNotification(notificationType NotificationType, factory func() interface{}) {
+	notificationMutex.Lock()
+	defer notificationMutex.Unlock()
+
+	notificationTypes[notificationType] = factory
+}
+
+// GetCharacterNotifications gets the characters most recent notifications
+func (esi Client) GetCharacterNotifications(characterID uint32, token string) ([]Notification, error) {
+	var notifications []Notification
+	err := esi.authGet(fmt.Sprintf("/v6/characters/%d/notifications/", characterID), token, &notifications)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// Parse decodes the yaml body of the notification into the struct registered for its type.
+// Types without a registered struct are returned as a map[string]interface{}.
+func (notification Notification) Parse() (interface{}, error) {
+	notificationMutex.RLock()
+	factory, ok := notificationTypes[notification.Type]
+	notificationMutex.RUnlock()
+
+	if !ok {
+		raw := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(notification.Text), &raw); err != nil {
+			return nil, err
+		}
+
+		return raw, nil
+	}
+
+	body := factory()
+	if err := yaml.Unmarshal([]byte(notification.Text), body); err != nil {
+		return nil, fmt.Errorf("failed to parse %s notification %d: %w", notification.Type, notification.NotificationID, err)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,404 @@
+package esi
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// item group ids of planetary interaction pins that can store commodities
+const (
+	groupCommandCenter   uint32 = 1027
+	groupStorageFacility uint32 = 1029
+	groupSpaceport       uint32 = 1030
+)
+
+// CharacterPlanet is a planet the character has a colony on
+type CharacterPlanet struct {
+	LastUpdate    time.Time `json:"last_update"`
+	NumPins       int32     `json:"num_pins"`
+	OwnerID       uint32    `json:"owner_id"`
+	PlanetID      uint32    `json:"planet_id"`
+	PlanetType    string    `json:"planet_type"`
+	SolarSystemID uint32    `json:"solar_system_id"`
+	UpgradeLevel  int32     `json:"upgrade_level"`
+}
+
+// ColonyLayout is the full layout of pins, links and routes in a colony
+type ColonyLayout struct {
+	Links  []PlanetLink  `json:"links"`
+	Pins   []PlanetPin   `json:"pins"`
+	Routes []PlanetRoute `json:"routes"`
+}
+
+// PlanetLink is a link between two pins
+type PlanetLink struct {
+	DestinationPinID int64 `json:"destination_pin_id"`
+	LinkLevel        int32 `json:"link_level"`
+	SourcePinID      int64 `json:"source_pin_id"`
+}
+
+// PlanetRoute is a route that moves commodities between pins
+type PlanetRoute struct {
+	ContentTypeID    uint32  `json:"content_type_id"`
+	DestinationPinID int64   `json:"destination_pin_id"`
+	Quantity         float32 `json:"quantity"`
+	RouteID          int64   `json:"route_id"`
+	SourcePinID      int64   `json:"source_pin_id"`
+	Waypoints        []int64 `json:"waypoints,omitempty"`
+}
+
+// PinContent is a commodity stored in a pin
+type PinContent struct {
+	Amount int64  `json:"amount"`
+	TypeID uint32 `json:"type_id"`
+}
+
+// ExtractorHead is a single head of an extractor control unit
+type ExtractorHead struct {
+	HeadID    int32   `json:"head_id"`
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+}
+
+// ExtractorDetails is the program an extractor control unit is running
+type ExtractorDetails struct {
+	CycleTime     int32           `json:"cycle_time,omitempty"`
+	HeadRadius    float32         `json:"head_radius,omitempty"`
+	Heads         []ExtractorHead `json:"heads"`
+	ProductTypeID uint32          `json:"product_type_id,omitempty"`
+	QtyPerCycle   int32           `json:"qty_per_cycle,omitempty"`
+}
+
+// FactoryDetails is the schematic a processor is running
+type FactoryDetails struct {
+	SchematicID uint32 `json:"schematic_id"`
+}
+
+// PlanetPin is a structure placed in a colony
+type PlanetPin struct {
+	Contents         []PinContent      `json:"contents,omitempty"`
+	ExpiryTime       *time.Time        `json:"expiry_time,omitempty"`
+	ExtractorDetails *ExtractorDetails `json:"extractor_details,omitempty"`
+	FactoryDetails   *FactoryDetails   `json:"factory_details,omitempty"`
+	InstallTime      *time.Time        `json:"install_time,omitempty"`
+	LastCycleStart   *time.Time        `json:"last_cycle_start,omitempty"`
+	Latitude         float32           `json:"latitude"`
+	Longitude        float32           `json:"longitude"`
+	PinID            int64             `json:"pin_id"`
+	SchematicID      uint32            `json:"schematic_id,omitempty"`
+	TypeID           uint32            `json:"type_id"`
+}
+
+// ExtractorStatus is the state of an extractor program in a colony
+type ExtractorStatus struct {
+	PinID         int64
+	ProductTypeID uint32
+	Expires       time.Time
+	UnitsPerHour  float64
+}
+
+// StorageInflow is the volume a route moves into a storage pin, Until is when the extractor feeding it stops (zero for factories).
+// Routes taking commodities out of the pin to a factory have a negative PerHour.
+type StorageInflow struct {
+	SourcePinID int64
+	PerHour     float64
+	Until       time.Time
+}
+
+// StorageStatus is how full a storage pin is and when it will fill up at the current inflow
+type StorageStatus struct {
+	PinID          int64
+	TypeID         uint32
+	Capacity       float64
+	Used           float64
+	InflowPerHour  float64 // net of what factories draw, never below 0
+	Inflows        []StorageInflow
+	FullAt         time.Time
+	LastUpdateTime time.Time
+}
+
+// FactoryStatus is the throughput of a processor in a colony
+type FactoryStatus struct {
+	PinID         int64
+	SchematicID   uint32
+	Name          string
+	CyclesPerHour float64
+}
+
+// ColonyReport is the summary of a single colony
+type ColonyReport struct {
+	Planet     CharacterPlanet
+	Name       string
+	Extractors []ExtractorStatus
+	Storage    []StorageStatus
+	Factories  []FactoryStatus
+}
+
+// GetCharacterPlanets gets the planets the character has colonies on
+func (esi Client) GetCharacterPlanets(characterID uint32, token string) ([]CharacterPlanet, error) {
+	var planets []CharacterPlanet
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/planets/", characterID), token, &planets)
+	if err != nil {
+		return nil, err
+	}
+
+	return planets, nil
+}
+
+// GetColonyLayout gets the full layout of the characters colony on a planet
+func (esi Client) GetColonyLayout(characterID uint32, planetID uint32, token string) (*ColonyLayout, error) {
+	var layout ColonyLayout
+	err := esi.authGet(fmt.Sprintf("/v3/characters/%d/planets/%d/", characterID, planetID), token, &layout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &layout, nil
+}
+
+// ExtractorsExpire gets the earliest time an extractor program in the colony ends, zero when there are no extractors running
+func (report ColonyReport) ExtractorsExpire() time.Time {
+	var earliest time.Time
+	for _, extractor := range report.Extractors {
+		if earliest.IsZero() || extractor.Expires.Before(earliest) {
+			earliest = extractor.Expires
+		}
+	}
+
+	return earliest
+}
+
+// FillAt gets the projected volume stored in the pin at the specified time, capped at its capacity.
+// Extractor inflow stops once its program expires.
+func (storage StorageStatus) FillAt(at time.Time) float64 {
+	start, used := storage.LastUpdateTime, storage.Used
+	for _, stop := range append(storage.stops(), at) {
+		if !stop.Before(at) {
+			stop = at
+		}
+
+		if stop.After(start) {
+			used += storage.netRate(start) * stop.Sub(start).Hours()
+			start = stop
+		}
+	}
+
+	return min(storage.Capacity, used)
+}
+
+// fullAt works out when the inflows fill the pin, stepping through each point an extractor stops. Zero if it never fills.
+func (storage StorageStatus) fullAt() time.Time {
+	stops := storage.stops()
+
+	start, used := storage.LastUpdateTime, storage.Used
+	for i := 0; i <= len(stops); i++ {
+		rate := storage.netRate(start)
+		if rate <= 0 {
+			if i == len(stops) {
+				return time.Time{}
+			}
+
+			start = stops[i]
+			continue
+		}
+
+		full := start.Add(time.Duration((storage.Capacity - used) / rate * float64(time.Hour)))
+		if i == len(stops) || !full.After(stops[i]) {
+			return full
+		}
+
+		used += rate * stops[i].Sub(start).Hours()
+		start = stops[i]
+	}
+
+	return time.Time{}
+}
+
+// stops gets when each extractor feeding the pin stops after the last update, in order
+func (storage StorageStatus) stops() []time.Time {
+	stops := []time.Time{}
+	for _, inflow := range storage.Inflows {
+		if !inflow.Until.IsZero() && inflow.Until.After(storage.LastUpdateTime) {
+			stops = append(stops, inflow.Until)
+		}
+	}
+
+	sort.Slice(stops, func(i, j int) bool { return stops[i].Before(stops[j]) })
+	return stops
+}
+
+// netRate is the volume per hour going into the pin at the time, factory draw can't take it below nothing so it's clamped at 0
+func (storage StorageStatus) netRate(at time.Time) float64 {
+	rate := 0.0
+	for _, inflow := range storage.Inflows {
+		if inflow.Until.IsZero() || inflow.Until.After(at) {
+			rate += inflow.PerHour
+		}
+	}
+
+	return max(0, rate)
+}
+
+// GetColonyReport builds extractor expiry, storage fill and factory throughput for one of the characters colonies
+func (esi Client) GetColonyReport(characterID uint32, planet CharacterPlanet, token string) (*ColonyReport, error) {
+	layout, err := esi.GetColonyLayout(characterID, planet.PlanetID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := esi.GetPlanet(planet.PlanetID)
+	if err != nil {
+		return nil, err
+	}
+
+	types := map[uint32]UniverseType{}
+	getType := func(id uint32) (UniverseType, error) {
+		if item, ok := types[id]; ok {
+			return item, nil
+		}
+
+		item, err := esi.GetType(id)
+		if err != nil {
+			return UniverseType{}, err
+		}
+
+		types[id] = item
+		return item, nil
+	}
+
+	schematics := map[uint32]Schematic{}
+	getSchematic := func(id uint32) (Schematic, error) {
+		if schematic, ok := schematics[id]; ok {
+			return schematic, nil
+		}
+
+		schematic, err := esi.GetSchematic(id)
+		if err != nil {
+			return Schematic{}, err
+		}
+
+		schematics[id] = schematic
+		return schematic, nil
+	}
+
+	report := &ColonyReport{
+		Planet: planet,
+		Name:   info.Name,
+	}
+
+	// seconds per cycle for every pin that produces something, used to work out route throughput
+	cycles := map[int64]float64{}
+	// when each extractor stops, inflow from it isn't projected past this
+	expires := map[int64]time.Time{}
+
+	for _, pin := range layout.Pins {
+		if pin.ExtractorDetails != nil && pin.ExtractorDetails.CycleTime > 0 {
+			extractor := ExtractorStatus{
+				PinID:         pin.PinID,
+				ProductTypeID: pin.ExtractorDetails.ProductTypeID,
+				UnitsPerHour:  float64(pin.ExtractorDetails.QtyPerCycle) * 3600 / float64(pin.ExtractorDetails.CycleTime),
+			}
+
+			if pin.ExpiryTime != nil {
+				extractor.Expires = *pin.ExpiryTime
+				expires[pin.PinID] = extractor.Expires
+			}
+
+			cycles[pin.PinID] = float64(pin.ExtractorDetails.CycleTime)
+			report.Extractors = append(report.Extractors, extractor)
+		}
+
+		schematicID := pin.SchematicID
+		if pin.FactoryDetails != nil {
+			schematicID = pin.FactoryDetails.SchematicID
+		}
+
+		if schematicID != 0 {
+			schematic, err := getSchematic(schematicID)
+			if err != nil {
+				return nil, err
+			}
+
+			cycles[pin.PinID] = float64(schematic.CycleTime)
+			report.Factories = append(report.Factories, FactoryStatus{
+				PinID:         pin.PinID,
+				SchematicID:   schematicID,
+				Name:          schematic.SchematicName,
+				CyclesPerHour: 3600 / float64(schematic.CycleTime),
+			})
+		}
+	}
+
+	for _, pin := range layout.Pins {
+		item, err := getType(pin.TypeID)
+		if err != nil {
+			return nil, err
+		}
+
+		if item.GroupID != groupCommandCenter && item.GroupID != groupStorageFacility && item.GroupID != groupSpaceport {
+			continue
+		}
+
+		storage := StorageStatus{
+			PinID:          pin.PinID,
+			TypeID:         pin.TypeID,
+			Capacity:       float64(item.Capacity),
+			LastUpdateTime: planet.LastUpdate,
+		}
+
+		for _, content := range pin.Contents {
+			commodity, err := getType(content.TypeID)
+			if err != nil {
+				return nil, err
+			}
+
+			storage.Used += float64(content.Amount) * float64(commodity.Volume)
+		}
+
+		for _, route := range layout.Routes {
+			// routes into the pin run at the source's cycle, routes out of it to a factory run at the factory's cycle
+			inbound := route.DestinationPinID == pin.PinID
+			if !inbound && route.SourcePinID != pin.PinID {
+				continue
+			}
+
+			cyclePin := route.SourcePinID
+			if !inbound {
+				cyclePin = route.DestinationPinID
+			}
+
+			seconds, ok := cycles[cyclePin]
+			if !ok || seconds == 0 {
+				continue
+			}
+
+			commodity, err := getType(route.ContentTypeID)
+			if err != nil {
+				return nil, err
+			}
+
+			inflow := StorageInflow{
+				SourcePinID: route.SourcePinID,
+				PerHour:     float64(route.Quantity) * float64(commodity.Volume) * 3600 / seconds,
+			}
+
+			if inbound {
+				inflow.Until = expires[route.SourcePinID]
+			} else {
+				inflow.PerHour = -inflow.PerHour
+			}
+
+			storage.Inflows = append(storage.Inflows, inflow)
+		}
+
+		storage.InflowPerHour = storage.netRate(storage.LastUpdateTime)
+		if storage.InflowPerHour > 0 && storage.Capacity > storage.Used {
+			storage.FullAt = storage.fullAt()
+		}
+
+		report.Storage = append(report.Storage, storage)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,279 @@
+package esi
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CorporationRole is a role a character can be granted in their corporation
+type CorporationRole string
+
+const (
+	RoleAccountTake1            CorporationRole = "Account_Take_1"
+	RoleAccountTake2            CorporationRole = "Account_Take_2"
+	RoleAccountTake3            CorporationRole = "Account_Take_3"
+	RoleAccountTake4            CorporationRole = "Account_Take_4"
+	RoleAccountTake5            CorporationRole = "Account_Take_5"
+	RoleAccountTake6            CorporationRole = "Account_Take_6"
+	RoleAccountTake7            CorporationRole = "Account_Take_7"
+	RoleAccountant              CorporationRole = "Accountant"
+	RoleAuditor                 CorporationRole = "Auditor"
+	RoleBrandManager            CorporationRole = "Brand_Manager"
+	RoleCommunicationsOfficer   CorporationRole = "Communications_Officer"
+	RoleConfigEquipment         CorporationRole = "Config_Equipment"
+	RoleConfigStarbaseEquipment CorporationRole = "Config_Starbase_Equipment"
+	RoleContainerTake1          CorporationRole = "Container_Take_1"
+	RoleContainerTake2          CorporationRole = "Container_Take_2"
+	RoleContainerTake3          CorporationRole = "Container_Take_3"
+	RoleContainerTake4          CorporationRole = "Container_Take_4"
+	RoleContainerTake5          CorporationRole = "Container_Take_5"
+	RoleContainerTake6          CorporationRole = "Container_Take_6"
+	RoleContainerTake7          CorporationRole = "Container_Take_7"
+	RoleContractManager         CorporationRole = "Contract_Manager"
+	RoleDeliveriesContainerTake CorporationRole = "Deliveries_Container_Take"
+	RoleDeliveriesQuery         CorporationRole = "Deliveries_Query"
+	RoleDeliveriesTake          CorporationRole = "Deliveries_Take"
+	RoleDiplomat                CorporationRole = "Diplomat"
+	RoleDirector                CorporationRole = "Director"
+	RoleFactoryManager          CorporationRole = "Factory_Manager"
+	RoleFittingManager          CorporationRole = "Fitting_Manager"
+	RoleHangarQuery1            CorporationRole = "Hangar_Query_1"
+	RoleHangarQuery2            CorporationRole = "Hangar_Query_2"
+	RoleHangarQuery3            CorporationRole = "Hangar_Query_3"
+	RoleHangarQuery4            CorporationRole = "Hangar_Query_4"
+	RoleHangarQuery5            CorporationRole = "Hangar_Query_5"
+	RoleHangarQuery6            CorporationRole = "Hangar_Query_6"
+	RoleHangarQuery7            CorporationRole = "Hangar_Query_7"
+	RoleHangarTake1             CorporationRole = "Hangar_Take_1"
+	RoleHangarTake2             CorporationRole = "Hangar_Take_2"
+	RoleHangarTake3             CorporationRole = "Hangar_Take_3"
+	RoleHangarTake4             CorporationRole = "Hangar_Take_4"
+	RoleHangarTake5             CorporationRole = "Hangar_Take_5"
+	RoleHangarTake6             CorporationRole = "Hangar_Take_6"
+	RoleHangarTake7             CorporationRole = "Hangar_Take_7"
+	RoleJuniorAccountant        CorporationRole = "Junior_Accountant"
+	RolePersonnelManager        CorporationRole = "Personnel_Manager"
+	RoleProjectManager          CorporationRole = "Project_Manager"
+	RoleRentFactoryFacility     CorporationRole = "Rent_Factory_Facility"
+	RoleRentOffice              CorporationRole = "Rent_Office"
+	RoleRentResearchFacility    CorporationRole = "Rent_Research_Facility"
+	RoleSecurityOfficer         CorporationRole = "Security_Officer"
+	RoleSkillPlanManager        CorporationRole = "Skill_Plan_Manager"
+	RoleStarbaseDefenseOperator CorporationRole = "Starbase_Defense_Operator"
+	RoleStarbaseFuelTechnician  CorporationRole = "Starbase_Fuel_Technician"
+	RoleStationManager          CorporationRole = "Station_Manager"
+	RoleTrader                  CorporationRole = "Trader"
+)
+
+var knownRoles = map[CorporationRole]bool{
+	RoleAccountTake1:            true,
+	RoleAccountTake2:            true,
+	RoleAccountTake3:            true,
+	RoleAccountTake4:            true,
+	RoleAccountTake5:            true,
+	RoleAccountTake6:            true,
+	RoleAccountTake7:            true,
+	RoleAccountant:              true,
+	RoleAuditor:                 true,
+	RoleBrandManager:            true,
+	RoleCommunicationsOfficer:   true,
+	RoleConfigEquipment:         true,
+	RoleConfigStarbaseEquipment: true,
+	RoleContainerTake1:          true,
+	RoleContainerTake2:          true,
+	RoleContainerTake3:          true,
+	RoleContainerTake4:          true,
+	RoleContainerTake5:          true,
+	RoleContainerTake6:          true,
+	RoleContainerTake7:          true,
+	RoleContractManager:         true,
+	RoleDeliveriesContainerTake: true,
+	RoleDeliveriesQuery:         true,
+	RoleDeliveriesTake:          true,
+	RoleDiplomat:                true,
+	RoleDirector:                true,
+	RoleFactoryManager:          true,
+	RoleFittingManager:          true,
+	RoleHangarQuery1:            true,
+	RoleHangarQuery2:            true,
+	RoleHangarQuery3:            true,
+	RoleHangarQuery4:            true,
+	RoleHangarQuery5:            true,
+	RoleHangarQuery6:            true,
+	RoleHangarQuery7:            true,
+	RoleHangarTake1:             true,
+	RoleHangarTake2:             true,
+	RoleHangarTake3:             true,
+	RoleHangarTake4:             true,
+	RoleHangarTake5:             true,
+	RoleHangarTake6:             true,
+	RoleHangarTake7:             true,
+	RoleJuniorAccountant:        true,
+	RolePersonnelManager:        true,
+	RoleProjectManager:          true,
+	RoleRentFactoryFacility:     true,
+	RoleRentOffice:              true,
+	RoleRentResearchFacility:    true,
+	RoleSecurityOfficer:         true,
+	RoleSkillPlanManager:        true,
+	RoleStarbaseDefenseOperator: true,
+	RoleStarbaseFuelTechnician:  true,
+	RoleStationManager:          true,
+	RoleTrader:                  true,
+}
+
+// RoleScope is where in the corporation a role applies
+type RoleScope int
+
+const (
+	ScopeGlobal RoleScope = iota
+	ScopeBase
+	ScopeHQ
+	ScopeOther
+)
+
+// DivisionType is the kind of corporation division
+type DivisionType int
+
+const (
+	DivisionHangar DivisionType = iota
+	DivisionWallet
+)
+
+// RoleSet is a set of corporation roles
+type RoleSet map[CorporationRole]bool
+
+// CorporationRoles are a characters roles split by the scope they apply to
+type CorporationRoles struct {
+	Global RoleSet
+	Base   RoleSet
+	HQ     RoleSet
+	Other  RoleSet
+}
+
+// IsKnown checks if the role is one of the roles esi documents
+func (role CorporationRole) IsKnown() bool {
+	return knownRoles[role]
+}
+
+// HangarQueryRole gets the role that allows viewing the contents of a hangar division (1-7)
+func HangarQueryRole(division int) CorporationRole {
+	return CorporationRole(fmt.Sprintf("Hangar_Query_%d", division))
+}
+
+// HangarTakeRole gets the role that allows taking items from a hangar division (1-7)
+func HangarTakeRole(division int) CorporationRole {
+	return CorporationRole(fmt.Sprintf("Hangar_Take_%d", division))
+}
+
+// ContainerTakeRole gets the role that allows taking items from containers in a hangar division (1-7)
+func ContainerTakeRole(division int) CorporationRole {
+	return CorporationRole(fmt.Sprintf("Container_Take_%d", division))
+}
+
+// AccountTakeRole gets the role that allows taking isk from a wallet division (1-7)
+func AccountTakeRole(division int) CorporationRole {
+	return CorporationRole(fmt.Sprintf("Account_Take_%d", division))
+}
+
+func createRoleSet(names []string) RoleSet {
+	set := RoleSet{}
+	for _, name := range names {
+		set[CorporationRole(name)] = true
+	}
+
+	return set
+}
+
+// Has checks if the role is in the set
+func (set RoleSet) Has(role CorporationRole) bool {
+	return set[role]
+}
+
+// Sorted gets the roles in the set in alphabetical order
+func (set RoleSet) Sorted() []CorporationRole {
+	roles := make([]CorporationRole, 0, len(set))
+	for role := range set {
+		roles = append(roles, role)
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i] < roles[j] })
+	return roles
+}
+
+// Parse converts the role names into typed role sets, role names esi adds later are kept as they are
+func (roles Roles) Parse() CorporationRoles {
+	return CorporationRoles{
+		Global: createRoleSet(roles.Roles),
+		Base:   createRoleSet(roles.BaseRoles),
+		HQ:     createRoleSet(roles.HQRoles),
+		Other:  createRoleSet(roles.OtherRoles),
+	}
+}
+
+// Scope gets the roles granted for the scope
+func (roles CorporationRoles) Scope(scope RoleScope) RoleSet {
+	switch scope {
+	case ScopeBase:
+		return roles.Base
+	case ScopeHQ:
+		return roles.HQ
+	case ScopeOther:
+		return roles.Other
+	}
+
+	return roles.Global
+}
+
+// IsDirector checks if the character is a director, which implies every other role
+func (roles CorporationRoles) IsDirector() bool {
+	return roles.Global.Has(RoleDirector)
+}
+
+// HasRole checks if the character has the role at the scope, either granted there directly or globally
+func (roles CorporationRoles) HasRole(role CorporationRole, scope RoleScope) bool {
+	return roles.IsDirector() || roles.Global.Has(role) || roles.Scope(scope).Has(role)
+}
+
+// CanAccessDivision checks if the character can view (or take from, when take is set) a hangar or wallet division (1-7).
+// Wallet access isn't tied to a location so the scope is only used for hangars.
+func (roles CorporationRoles) CanAccessDivision(division DivisionType, number int, scope RoleScope, take bool) bool {
+	if number < 1 || number > 7 {
+		return false
+	}
+
+	if roles.IsDirector() {
+		return true
+	}
+
+	switch division {
+	case DivisionWallet:
+		if roles.Global.Has(AccountTakeRole(number)) {
+			return true
+		}
+
+		return !take && (roles.Global.Has(RoleAccountant) || roles.Global.Has(RoleJuniorAccountant))
+	case DivisionHangar:
+		if roles.HasRole(HangarTakeRole(number), scope) {
+			return true
+		}
+
+		return !take && roles.HasRole(HangarQueryRole(number), scope)
+	}
+
+	return false
+}
+
+// Unknown gets every role name in any scope that isn't a documented esi role
+func (roles CorporationRoles) Unknown() []CorporationRole {
+	unknown := RoleSet{}
+	for _, set := range []RoleSet{roles.Global, roles.Base, roles.HQ, roles.Other} {
+		for role := range set {
+			if !role.IsKnown() {
+				unknown[role] = true
+			}
+		}
+	}
+
+	return unknown.Sorted()
+}
@@ -0,0 +1,277 @@
+package esi
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// dogma attribute ids used for skill training calculations
+const (
+	attributeCharisma          uint32 = 164
+	attributeIntelligence      uint32 = 165
+	attributeMemory            uint32 = 166
+	attributePerception        uint32 = 167
+	attributeWillpower         uint32 = 168
+	attributeCharismaBonus     uint32 = 175
+	attributeIntelligenceBonus uint32 = 176
+	attributeMemoryBonus       uint32 = 177
+	attributePerceptionBonus   uint32 = 178
+	attributeWillpowerBonus    uint32 = 179
+	attributePrimary           uint32 = 180
+	attributeSecondary         uint32 = 181
+	attributeSkillRank         uint32 = 275
+)
+
+// CharacterSkill is a skill the character has injected
+type CharacterSkill struct {
+	ActiveLevel  int   `json:"active_skill_level"`
+	SkillID      int32 `json:"skill_id"`
+	SkillPoints  int64 `json:"skillpoints_in_skill"`
+	TrainedLevel int   `json:"trained_skill_level"`
+}
+
+// Skills is the full list of injected skills for a character
+type Skills struct {
+	Skills        []CharacterSkill `json:"skills"`
+	TotalSP       int64            `json:"total_sp"`
+	UnallocatedSP int32            `json:"unallocated_sp,omitempty"`
+}
+
+// SkillQueueEntry is a single skill level in the characters training queue
+type SkillQueueEntry struct {
+	FinishDate      *time.Time `json:"finish_date,omitempty"`
+	FinishedLevel   int        `json:"finished_level"`
+	LevelEndSP      int32      `json:"level_end_sp,omitempty"`
+	LevelStartSP    int32      `json:"level_start_sp,omitempty"`
+	QueuePosition   int        `json:"queue_position"`
+	SkillID         int32      `json:"skill_id"`
+	StartDate       *time.Time `json:"start_date,omitempty"`
+	TrainingStartSP int32      `json:"training_start_sp,omitempty"`
+}
+
+// Attributes are the characters base attributes, not including any implant bonuses
+type Attributes struct {
+	AccruedRemapCooldownDate *time.Time `json:"accrued_remap_cooldown_date,omitempty"`
+	BonusRemaps              int        `json:"bonus_remaps,omitempty"`
+	Charisma                 int        `json:"charisma"`
+	Intelligence             int        `json:"intelligence"`
+	LastRemapDate            *time.Time `json:"last_remap_date,omitempty"`
+	Memory                   int        `json:"memory"`
+	Perception               int        `json:"perception"`
+	Willpower                int        `json:"willpower"`
+}
+
+// SkillTarget is a level the character wants to train a skill to
+type SkillTarget struct {
+	SkillID uint32
+	Level   int
+}
+
+// SkillPlanEntry is the time needed to train a single level of a skill
+type SkillPlanEntry struct {
+	SkillID     uint32
+	Name        string
+	Level       int
+	SkillPoints int64
+	Duration    time.Duration
+}
+
+// SkillPlan is the ordered list of skill levels needed to reach a set of targets
+type SkillPlan struct {
+	Entries     []SkillPlanEntry
+	SkillPoints int64
+	Duration    time.Duration
+}
+
+// GetCharacterSkills gets all the skills the character has injected
+func (esi Client) GetCharacterSkills(characterID uint32, token string) (Skills, error) {
+	var skills Skills
+	err := esi.authGet(fmt.Sprintf("/v4/characters/%d/skills/", characterID), token, &skills)
+	if err != nil {
+		return Skills{}, err
+	}
+
+	return skills, nil
+}
+
+// GetCharacterSkillQueue gets the characters current skill queue
+func (esi Client) GetCharacterSkillQueue(characterID uint32, token string) ([]SkillQueueEntry, error) {
+	var queue []SkillQueueEntry
+	err := esi.authGet(fmt.Sprintf("/v2/characters/%d/skillqueue/", characterID), token, &queue)
+	if err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+// GetCharacterAttributes gets the characters attributes and remap information
+func (esi Client) GetCharacterAttributes(characterID uint32, token string) (Attributes, error) {
+	var attributes Attributes
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/attributes/", characterID), token, &attributes)
+	if err != nil {
+		return Attributes{}, err
+	}
+
+	return attributes, nil
+}
+
+// IsQueueEmpty checks if there is nothing left training in the queue
+func IsQueueEmpty(queue []SkillQueueEntry, now time.Time) bool {
+	for _, entry := range queue {
+		if entry.FinishDate == nil || entry.FinishDate.After(now) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsQueuePaused checks if the queue has skills in it but none of them are currently training
+func IsQueuePaused(queue []SkillQueueEntry) bool {
+	if len(queue) == 0 {
+		return false
+	}
+
+	for _, entry := range queue {
+		if entry.FinishDate != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// QueueRemaining gets how long is left until the last skill in the queue finishes training
+func QueueRemaining(queue []SkillQueueEntry, now time.Time) time.Duration {
+	var last time.Time
+	for _, entry := range queue {
+		if entry.FinishDate != nil && entry.FinishDate.After(last) {
+			last = *entry.FinishDate
+		}
+	}
+
+	if last.Before(now) {
+		return 0
+	}
+
+	return last.Sub(now)
+}
+
+// SkillPointsForLevel gets the total skill points needed to have a skill of the specified rank at the level
+func SkillPointsForLevel(rank float64, level int) int64 {
+	if level <= 0 {
+		return 0
+	}
+
+	return int64(math.Ceil(250 * rank * math.Pow(math.Sqrt(32), float64(level-1))))
+}
+
+// SkillPointsPerMinute gets the training rate for the primary and secondary attribute values
+func SkillPointsPerMinute(primary int, secondary int) float64 {
+	return float64(primary) + float64(secondary)/2
+}
+
+// PlanSkillTraining calculates how long the character needs to train the targets, using the implants plugged into the active clone
+func (esi Client) PlanSkillTraining(skills Skills, attributes Attributes, implants []uint32, targets []SkillTarget) (*SkillPlan, error) {
+	values := map[uint32]int{
+		attributeCharisma:     attributes.Charisma,
+		attributeIntelligence: attributes.Intelligence,
+		attributeMemory:       attributes.Memory,
+		attributePerception:   attributes.Perception,
+		attributeWillpower:    attributes.Willpower,
+	}
+
+	bonuses := map[uint32]uint32{
+		attributeCharismaBonus:     attributeCharisma,
+		attributeIntelligenceBonus: attributeIntelligence,
+		attributeMemoryBonus:       attributeMemory,
+		attributePerceptionBonus:   attributePerception,
+		attributeWillpowerBonus:    attributeWillpower,
+	}
+
+	for _, implantID := range implants {
+		implant, err := esi.GetType(implantID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, attribute := range implant.DogmaAttributes {
+			if target, ok := bonuses[attribute.AttributeID]; ok {
+				values[target] += int(attribute.Value)
+			}
+		}
+	}
+
+	current := map[uint32]CharacterSkill{}
+	for _, skill := range skills.Skills {
+		current[uint32(skill.SkillID)] = skill
+	}
+
+	wanted := map[uint32]int{}
+	var order []uint32
+	for _, target := range targets {
+		if _, ok := wanted[target.SkillID]; !ok {
+			order = append(order, target.SkillID)
+		}
+
+		wanted[target.SkillID] = max(wanted[target.SkillID], target.Level)
+	}
+
+	plan := &SkillPlan{}
+	for _, skillID := range order {
+		skill, err := esi.GetType(skillID)
+		if err != nil {
+			return nil, err
+		}
+
+		var rank float64
+		var primary, secondary uint32
+		for _, attribute := range skill.DogmaAttributes {
+			switch attribute.AttributeID {
+			case attributeSkillRank:
+				rank = attribute.Value
+			case attributePrimary:
+				primary = uint32(attribute.Value)
+			case attributeSecondary:
+				secondary = uint32(attribute.Value)
+			}
+		}
+
+		if rank == 0 {
+			return nil, fmt.Errorf("type %d is not a skill", skillID)
+		}
+
+		rate := SkillPointsPerMinute(values[primary], values[secondary])
+		trained := current[skillID]
+
+		for level := trained.TrainedLevel + 1; level <= wanted[skillID]; level++ {
+			start := max(SkillPointsForLevel(rank, level-1), trained.SkillPoints)
+			needed := SkillPointsForLevel(rank, level) - start
+			if needed < 0 {
+				needed = 0
+			}
+
+			entry := SkillPlanEntry{
+				SkillID:     skillID,
+				Name:        skill.Name,
+				Level:       level,
+				SkillPoints: needed,
+				Duration:    time.Duration(float64(needed) / rate * float64(time.Minute)),
+			}
+
+			plan.Entries = append(plan.Entries, entry)
+			plan.SkillPoints += entry.SkillPoints
+			plan.Duration += entry.Duration
+		}
+	}
+
+	// Train lower levels first so prerequisites line up the same way the in game queue would
+	sort.SliceStable(plan.Entries, func(i, j int) bool {
+		return plan.Entries[i].Level < plan.Entries[j].Level
+	})
+
+	return plan, nil
+}
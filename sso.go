@@ -0,0 +1,151 @@
+package esi
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ssoHost         = "login.eveonline.com"
+	ssoAuthorizeURI = "https://login.eveonline.com/v2/oauth/authorize"
+	ssoTokenURI     = "https://login.eveonline.com/v2/oauth/token"
+	ssoKeysURI      = "https://login.eveonline.com/oauth/jwks"
+)
+
+// SSO is a client for authenticating characters against EVE Online single sign on
+type SSO struct {
+	clientID     string
+	clientSecret string
+	callbackURL  string
+	client       *http.Client
+
+	keysMutex sync.Mutex
+	keys      map[string]interface{}
+}
+
+// Token is the set of tokens returned from the sso token endpoint
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	ExpiresIn    int       `json:"expires_in"`
+	TokenType    string    `json:"token_type"`
+	RefreshToken string    `json:"refresh_token"`
+	Expiry       time.Time `json:"-"`
+}
+
+// AuthCharacter is the character that a validated access token was issued for
+type AuthCharacter struct {
+	ID        uint32
+	Name      string
+	Owner     string
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+// CreateSSO creates a new instance of the SSO client for the specified application
+func CreateSSO(httpClient *http.Client, clientID string, clientSecret string, callbackURL string) *SSO {
+	return &SSO{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		callbackURL:  callbackURL,
+		client:       httpClient,
+	}
+}
+
+// AuthorizeURL builds the url a user needs to be sent to in order to sign in with the requested scopes
+func (sso *SSO) AuthorizeURL(state string, scopes []string) string {
+	return sso.authorizeURL(sso.callbackURL, state, scopes, url.Values{})
+}
+
+func (sso *SSO) authorizeURL(callbackURL string, state string, scopes []string, extra url.Values) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("redirect_uri", callbackURL)
+	query.Set("client_id", sso.clientID)
+	query.Set("state", state)
+	if len(scopes) > 0 {
+		query.Set("scope", strings.Join(scopes, " "))
+	}
+
+	for key, values := range extra {
+		for _, value := range values {
+			query.Add(key, value)
+		}
+	}
+
+	return ssoAuthorizeURI + "?" + query.Encode()
+}
+
+// Exchange trades the authorization code from the callback for a Token
+func (sso *SSO) Exchange(code string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+
+	return sso.requestToken(form)
+}
+
+// Refresh uses a refresh token to get a new access token
+func (sso *SSO) Refresh(refreshToken string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+
+	return sso.requestToken(form)
+}
+
+func (sso *SSO) requestToken(form url.Values) (*Token, error) {
+	// Applications without a secret (native/pkce apps) identify themselves in the body instead
+	if sso.clientSecret == "" {
+		form.Set("client_id", sso.clientID)
+	}
+
+	request, err := http.NewRequest("POST", ssoTokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if sso.clientSecret != "" {
+		request.SetBasicAuth(sso.clientID, sso.clientSecret)
+	}
+
+	response, err := sso.client.Do(attachHeaders(request))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, fmt.Errorf("sso token request failed with status %d: %s", response.StatusCode, data)
+	}
+
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+
+	token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return &token, nil
+}
+
+func randomString(size int) (string, error) {
+	buffer := make([]byte, size)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buffer), nil
+}
@@ -0,0 +1,77 @@
+package esi
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"time"
+)
+
+const stateCookieName = "esi_sso_state"
+
+// LoginCallback is called once a character has successfully signed in through the CallbackHandler
+type LoginCallback func(w http.ResponseWriter, r *http.Request, character *AuthCharacter, token *Token)
+
+// LoginHandler returns a handler that starts an sso login requesting the specified scopes
+func (sso *SSO) LoginHandler(scopes []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state, err := randomString(32)
+		if err != nil {
+			http.Error(w, "failed to start sign in", http.StatusInternalServerError)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     stateCookieName,
+			Value:    state,
+			Path:     "/",
+			MaxAge:   int((10 * time.Minute).Seconds()),
+			HttpOnly: true,
+			Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		http.Redirect(w, r, sso.AuthorizeURL(state, scopes), http.StatusFound)
+	})
+}
+
+// CallbackHandler returns a handler for the sso redirect that validates the login and passes the result to callback
+func (sso *SSO) CallbackHandler(callback LoginCallback) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(stateCookieName)
+
+		// The state cookie is single use, so clear it no matter how the callback turns out
+		http.SetCookie(w, &http.Cookie{
+			Name:     stateCookieName,
+			Value:    "",
+			Path:     "/",
+			MaxAge:   -1,
+			HttpOnly: true,
+		})
+
+		state := r.URL.Query().Get("state")
+		if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+			http.Error(w, "invalid sign in state", http.StatusBadRequest)
+			return
+		}
+
+		code := r.URL.Query().Get("code")
+		if code == "" {
+			http.Error(w, "missing authorization code", http.StatusBadRequest)
+			return
+		}
+
+		token, err := sso.Exchange(code)
+		if err != nil {
+			http.Error(w, "failed to exchange authorization code", http.StatusBadGateway)
+			return
+		}
+
+		character, err := sso.ValidateToken(token.AccessToken)
+		if err != nil {
+			http.Error(w, "failed to validate access token", http.StatusUnauthorized)
+			return
+		}
+
+		callback(w, r, character, token)
+	})
+}
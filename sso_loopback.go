@@ -0,0 +1,145 @@
+package esi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type loopbackResult struct {
+	code string
+	err  error
+}
+
+// LoopbackLogin signs a character in without a web server by listening on the callback url for the sso redirect.
+// The callback url registered for the application must point at the local machine (ex: http://localhost:8080/callback).
+// When open is nil the authorize url is printed so the user can open it manually.
+func (sso *SSO) LoopbackLogin(ctx context.Context, scopes []string, timeout time.Duration, open func(string) error) (*AuthCharacter, *Token, error) {
+	callback, err := url.Parse(sso.callbackURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	host := callback.Hostname()
+	if callback.Scheme != "http" || (host != "localhost" && host != "127.0.0.1" && host != "::1") {
+		return nil, nil, fmt.Errorf("callback url %s is not a loopback http url", sso.callbackURL)
+	}
+
+	port := callback.Port()
+	if port == "" {
+		port = "80"
+	}
+
+	state, err := randomString(32)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verifier, err := randomString(32)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make(chan loopbackResult, 1)
+	server := &http.Server{
+		Handler: loopbackHandler(callback.Path, state, results),
+	}
+
+	go server.Serve(listener)
+	defer server.Close()
+
+	challenge := sha256.Sum256([]byte(verifier))
+	authorize := sso.authorizeURL(sso.callbackURL, state, scopes, url.Values{
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	})
+
+	if open == nil {
+		fmt.Printf("Open the following url in your browser to sign in:\n%s\n", authorize)
+	} else if err := open(authorize); err != nil {
+		return nil, nil, err
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var result loopbackResult
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case result = <-results:
+	}
+
+	if result.err != nil {
+		return nil, nil, result.err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", result.code)
+	form.Set("code_verifier", verifier)
+	form.Set("client_id", sso.clientID)
+
+	token, err := sso.requestToken(form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	character, err := sso.ValidateToken(token.AccessToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return character, token, nil
+}
+
+func loopbackHandler(path string, state string, results chan<- loopbackResult) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if path != "" && r.URL.Path != path {
+			http.NotFound(w, r)
+			return
+		}
+
+		// Ignore anything the browser asks for that isn't the redirect, ex: /favicon.ico
+		query := r.URL.Query()
+		if !query.Has("state") && !query.Has("code") {
+			http.NotFound(w, r)
+			return
+		}
+
+		var result loopbackResult
+		if query.Get("state") != state {
+			result.err = errors.New("sso callback returned an invalid state")
+		} else if query.Get("code") == "" {
+			result.err = errors.New("sso callback is missing the authorization code")
+		} else {
+			result.code = query.Get("code")
+		}
+
+		if result.err != nil {
+			http.Error(w, result.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprint(w, "Sign in complete, you can close this window.")
+		}
+
+		// Only the first callback counts, anything after that is ignored
+		select {
+		case results <- result:
+		default:
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package esi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrWaypointSkipped is reported for the rest of a route when clearing the old route failed
+var ErrWaypointSkipped = errors.New("waypoint skipped because the existing route couldn't be cleared")
+
+// NewMailWindow is the contents to prefill in a new mail window
+type NewMailWindow struct {
+	Body               string   `json:"body"`
+	Recipients         []uint32 `json:"recipients"`
+	Subject            string   `json:"subject"`
+	ToCorpOrAllianceID uint32   `json:"to_corp_or_alliance_id,omitempty"`
+	ToMailingListID    uint32   `json:"to_mailing_list_id,omitempty"`
+}
+
+// WaypointResult is the outcome of setting a single waypoint in a route
+type WaypointResult struct {
+	DestinationID int64
+	Err           error
+}
+
+// SetWaypoint adds a system, station or structure to the autopilot route in the characters client
+func (esi Client) SetWaypoint(destinationID int64, addToBeginning bool, clearOtherWaypoints bool, token string) error {
+	path := fmt.Sprintf(
+		"/v2/ui/autopilot/waypoint/?add_to_beginning=%t&clear_other_waypoints=%t&destination_id=%d",
+		addToBeginning,
+		clearOtherWaypoints,
+		destinationID,
+	)
+
+	return esi.authPost(path, token, nil, nil)
+}
+
+// SetRoute replaces the autopilot route in the characters client with the destinations in order.
+// The first waypoint clears any existing route and the rest are appended, each call's outcome is reported separately.
+// If the first waypoint fails the rest are skipped with ErrWaypointSkipped so they aren't added to the old route.
+func (esi Client) SetRoute(destinations []int64, token string) []WaypointResult {
+	results := make([]WaypointResult, 0, len(destinations))
+
+	for i, destinationID := range destinations {
+		if i > 0 && results[0].Err != nil {
+			results = append(results, WaypointResult{DestinationID: destinationID, Err: ErrWaypointSkipped})
+			continue
+		}
+
+		results = append(results, WaypointResult{
+			DestinationID: destinationID,
+			Err:           esi.SetWaypoint(destinationID, false, i == 0, token),
+		})
+	}
+
+	return results
+}
+
+// OpenInformationWindow opens the show info window for a character, corporation or alliance in the characters client
+func (esi Client) OpenInformationWindow(targetID uint32, token string) error {
+	return esi.authPost(fmt.Sprintf("/v1/ui/openwindow/information/?target_id=%d", targetID), token, nil, nil)
+}
+
+// OpenMarketDetails opens the market details window for a type in the characters client
+func (esi Client) OpenMarketDetails(typeID uint32, token string) error {
+	return esi.authPost(fmt.Sprintf("/v1/ui/openwindow/marketdetails/?type_id=%d", typeID), token, nil, nil)
+}
+
+// OpenContractWindow opens a contract in the characters client
+func (esi Client) OpenContractWindow(contractID int32, token string) error {
+	return esi.authPost(fmt.Sprintf("/v1/ui/openwindow/contract/?contract_id=%d", contractID), token, nil, nil)
+}
+
+// OpenNewMailWindow opens a prefilled new mail window in the characters client
+func (esi Client) OpenNewMailWindow(mail NewMailWindow, token string) error {
+	buffer, err := json.Marshal(mail)
+	if err != nil {
+		return err
+	}
+
+	return esi.authPost("/v1/ui/openwindow/newmail/", token, buffer, nil)
+}
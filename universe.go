@@ -231,3 +231,60 @@ func mapNames(names []NameRef) map[uint]NameRef {
 
 	return references
 }
+
+// Structure is a player owned structure that the character has docking access to
+type Structure struct {
+	Name          string   `json:"name,omitempty"`
+	OwnerID       uint32   `json:"owner_id,omitempty"`
+	Position      Position `json:"position,omitempty"`
+	SolarSystemID uint32   `json:"solar_system_id,omitempty"`
+	TypeID        uint32   `json:"type_id,omitempty"`
+}
+
+// GetStructure gets the public information of a structure the character has access to
+func (esi Client) GetStructure(id uint64, token string) (Structure, error) {
+	var structure Structure
+	err := esi.authGet(fmt.Sprintf("/v2/universe/structures/%d/", id), token, &structure)
+	if err != nil {
+		return Structure{}, err
+	}
+
+	return structure, nil
+}
+
+// PlanetInfo is the public information about a planet
+type PlanetInfo struct {
+	Name     string   `json:"name,omitempty"`
+	PlanetID uint32   `json:"planet_id,omitempty"`
+	Position Position `json:"position,omitempty"`
+	SystemID uint32   `json:"system_id,omitempty"`
+	TypeID   uint32   `json:"type_id,omitempty"`
+}
+
+// Schematic is a planetary interaction production schematic
+type Schematic struct {
+	CycleTime     int32  `json:"cycle_time,omitempty"`
+	SchematicName string `json:"schematic_name,omitempty"`
+}
+
+// GetPlanet gets the public information about a planet
+func (esi Client) GetPlanet(id uint32) (PlanetInfo, error) {
+	var planet PlanetInfo
+	err := esi.get(fmt.Sprintf("/v1/universe/planets/%d/", id), &planet)
+	if err != nil {
+		return PlanetInfo{}, err
+	}
+
+	return planet, nil
+}
+
+// GetSchematic gets a planetary interaction schematic
+func (esi Client) GetSchematic(id uint32) (Schematic, error) {
+	var schematic Schematic
+	err := esi.get(fmt.Sprintf("/v1/universe/schematics/%d/", id), &schematic)
+	if err != nil {
+		return Schematic{}, err
+	}
+
+	return schematic, nil
+}
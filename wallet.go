@@ -0,0 +1,241 @@
+package esi
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RefType is the type of transaction a wallet journal entry represents.
+// Only the common ref types have constants, esi has many more and any other value is kept as the plain string.
+type RefType string
+
+const (
+	RefAgentMissionReward           RefType = "agent_mission_reward"
+	RefAgentMissionTimeBonusReward  RefType = "agent_mission_time_bonus_reward"
+	RefAllianceMaintainanceFee      RefType = "alliance_maintainance_fee"
+	RefBounty                       RefType = "bounty_prize"
+	RefBountyPrizes                 RefType = "bounty_prizes"
+	RefBrokersFee                   RefType = "brokers_fee"
+	RefContractBrokersFee           RefType = "contract_brokers_fee"
+	RefContractCollateral           RefType = "contract_collateral"
+	RefContractPrice                RefType = "contract_price"
+	RefContractPricePaymentCorp     RefType = "contract_price_payment_corp"
+	RefContractReward               RefType = "contract_reward"
+	RefContractRewardDeposited      RefType = "contract_reward_deposited"
+	RefContractSalesTax             RefType = "contract_sales_tax"
+	RefCorporationAccountWithdrawal RefType = "corporation_account_withdrawal"
+	RefCorporateRewardPayout        RefType = "corporate_reward_payout"
+	RefDailyGoalPayouts             RefType = "daily_goal_payouts"
+	RefESSEscrowTransfer            RefType = "ess_escrow_transfer"
+	RefIndustryJobTax               RefType = "industry_job_tax"
+	RefInfrastructureHubMaintenance RefType = "infrastructure_hub_maintenance"
+	RefInsurance                    RefType = "insurance"
+	RefJumpCloneActivationFee       RefType = "jump_clone_activation_fee"
+	RefJumpCloneInstallationFee     RefType = "jump_clone_installation_fee"
+	RefMarketEscrow                 RefType = "market_escrow"
+	RefMarketTransaction            RefType = "market_transaction"
+	RefMarketProviderTax            RefType = "market_provider_tax"
+	RefOfficeRentalFee              RefType = "office_rental_fee"
+	RefPlanetaryConstruction        RefType = "planetary_construction"
+	RefPlanetaryExportTax           RefType = "planetary_export_tax"
+	RefPlanetaryImportTax           RefType = "planetary_import_tax"
+	RefPlayerDonation               RefType = "player_donation"
+	RefPlayerTrading                RefType = "player_trading"
+	RefProjectDiscoveryReward       RefType = "project_discovery_reward"
+	RefReprocessingTax              RefType = "reprocessing_tax"
+	RefSkillPurchase                RefType = "skill_purchase"
+	RefStructureGateJump            RefType = "structure_gate_jump"
+	RefTransactionTax               RefType = "transaction_tax"
+	RefWarFee                       RefType = "war_fee"
+)
+
+// JournalEntry is a single entry in the characters wallet journal
+type JournalEntry struct {
+	Amount        float64   `json:"amount,omitempty"`
+	Balance       float64   `json:"balance,omitempty"`
+	ContextID     int64     `json:"context_id,omitempty"`
+	ContextIDType string    `json:"context_id_type,omitempty"`
+	Date          time.Time `json:"date"`
+	Description   string    `json:"description"`
+	FirstPartyID  uint32    `json:"first_party_id,omitempty"`
+	ID            int64     `json:"id"`
+	Reason        string    `json:"reason,omitempty"`
+	RefType       RefType   `json:"ref_type"`
+	SecondPartyID uint32    `json:"second_party_id,omitempty"`
+	Tax           float64   `json:"tax,omitempty"`
+	TaxReceiverID uint32    `json:"tax_receiver_id,omitempty"`
+}
+
+// Transaction is a single market transaction made by the character
+type Transaction struct {
+	ClientID      uint32    `json:"client_id"`
+	Date          time.Time `json:"date"`
+	IsBuy         bool      `json:"is_buy"`
+	IsPersonal    bool      `json:"is_personal"`
+	JournalRefID  int64     `json:"journal_ref_id"`
+	LocationID    int64     `json:"location_id"`
+	Quantity      int32     `json:"quantity"`
+	TransactionID int64     `json:"transaction_id"`
+	TypeID        uint32    `json:"type_id"`
+	UnitPrice     float64   `json:"unit_price"`
+}
+
+// the transactions endpoint returns at most this many entries per request
+const transactionBatchSize = 2500
+
+// GetCharacterWallet gets the characters current wallet balance
+func (esi Client) GetCharacterWallet(characterID uint32, token string) (float64, error) {
+	var balance float64
+	err := esi.authGet(fmt.Sprintf("/v1/characters/%d/wallet/", characterID), token, &balance)
+	if err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// GetCharacterJournalPage gets a single page of the characters wallet journal along with the total page count
+func (esi Client) GetCharacterJournalPage(characterID uint32, page int, token string) ([]JournalEntry, int, error) {
+	var entries []JournalEntry
+	pages, err := esi.authGetPage(fmt.Sprintf("/v6/characters/%d/wallet/journal/", characterID), token, page, &entries)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, pages, nil
+}
+
+// GetCharacterJournal gets every page of the characters wallet journal
+func (esi Client) GetCharacterJournal(characterID uint32, token string) ([]JournalEntry, error) {
+	return getAllPages[JournalEntry](esi, fmt.Sprintf("/v6/characters/%d/wallet/journal/", characterID), token)
+}
+
+// GetCharacterTransactions gets the characters market transactions, starting at fromID and going back in time (0 for the most recent)
+func (esi Client) GetCharacterTransactions(characterID uint32, fromID int64, token string) ([]Transaction, error) {
+	path := fmt.Sprintf("/v1/characters/%d/wallet/transactions/", characterID)
+	if fromID > 0 {
+		path = fmt.Sprintf("%s?from_id=%d", path, fromID)
+	}
+
+	var transactions []Transaction
+	err := esi.authGet(path, token, &transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// WalletSync keeps track of the last journal and transaction ids seen for each character so only new entries are returned
+type WalletSync struct {
+	esi          Client
+	mutex        sync.Mutex
+	journal      map[uint32]int64
+	transactions map[uint32]int64
+}
+
+// CreateWalletSync creates a new instance of the WalletSync
+func CreateWalletSync(client *Client) *WalletSync {
+	return &WalletSync{
+		esi:          *client,
+		journal:      map[uint32]int64{},
+		transactions: map[uint32]int64{},
+	}
+}
+
+// Cursor gets the highest journal and transaction ids that have been seen for the character
+func (wallet *WalletSync) Cursor(characterID uint32) (int64, int64) {
+	wallet.mutex.Lock()
+	defer wallet.mutex.Unlock()
+
+	return wallet.journal[characterID], wallet.transactions[characterID]
+}
+
+// SetCursor restores previously seen journal and transaction ids, ex: after loading them from storage
+func (wallet *WalletSync) SetCursor(characterID uint32, journalID int64, transactionID int64) {
+	wallet.mutex.Lock()
+	defer wallet.mutex.Unlock()
+
+	wallet.journal[characterID] = journalID
+	wallet.transactions[characterID] = transactionID
+}
+
+// Journal gets the journal entries that are newer than the last call for this character, newest first
+func (wallet *WalletSync) Journal(characterID uint32, token string) ([]JournalEntry, error) {
+	last, _ := wallet.Cursor(characterID)
+
+	var entries []JournalEntry
+	highest := last
+
+	// The journal is sorted newest first, so stop paging as soon as an entry we've already seen shows up
+	for page, pages := 1, 1; page <= pages; page++ {
+		results, total, err := wallet.esi.GetCharacterJournalPage(characterID, page, token)
+		if err != nil {
+			return nil, err
+		}
+
+		pages = total
+		caughtUp := false
+		for _, entry := range results {
+			if entry.ID <= last {
+				caughtUp = true
+				continue
+			}
+
+			highest = max(highest, entry.ID)
+			entries = append(entries, entry)
+		}
+
+		if caughtUp {
+			break
+		}
+	}
+
+	wallet.mutex.Lock()
+	defer wallet.mutex.Unlock()
+	wallet.journal[characterID] = max(wallet.journal[characterID], highest)
+
+	return entries, nil
+}
+
+// Transactions gets the market transactions that are newer than the last call for this character, newest first
+func (wallet *WalletSync) Transactions(characterID uint32, token string) ([]Transaction, error) {
+	_, last := wallet.Cursor(characterID)
+
+	var transactions []Transaction
+	highest := last
+	var fromID int64
+
+	for {
+		results, err := wallet.esi.GetCharacterTransactions(characterID, fromID, token)
+		if err != nil {
+			return nil, err
+		}
+
+		caughtUp := len(results) < transactionBatchSize
+		for _, transaction := range results {
+			if transaction.TransactionID <= last {
+				caughtUp = true
+				continue
+			}
+
+			highest = max(highest, transaction.TransactionID)
+			transactions = append(transactions, transaction)
+
+			if fromID == 0 || transaction.TransactionID <= fromID {
+				fromID = transaction.TransactionID - 1
+			}
+		}
+
+		if caughtUp || fromID <= 0 {
+			break
+		}
+	}
+
+	wallet.mutex.Lock()
+	defer wallet.mutex.Unlock()
+	wallet.transactions[characterID] = max(wallet.transactions[characterID], highest)
+
+	return transactions, nil
+}
@@ -0,0 +1,250 @@
+package esi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// minimumPollInterval is used when esi doesn't send an Expires header, so a watcher can't hammer the api
+const minimumPollInterval = 30 * time.Second
+
+// WatchEventType is the kind of change a Watcher noticed
+type WatchEventType int
+
+const (
+	EventLoggedIn WatchEventType = iota
+	EventLoggedOut
+	EventJumped
+	EventDocked
+	EventUndocked
+	EventShipChanged
+	EventError
+)
+
+// WatchEvent is a change in a watched characters online status, location or ship
+type WatchEvent struct {
+	Type        WatchEventType
+	CharacterID uint32
+	Time        time.Time
+
+	// Location is the characters location after the change, PreviousLocation is where they were before
+	Location         Location
+	PreviousLocation Location
+
+	// Ship is the characters ship after the change, PreviousShip is what they were flying before
+	Ship         Ship
+	PreviousShip Ship
+
+	Err error
+}
+
+// TokenSource gets a valid access token for the character, refreshing it if needed
+type TokenSource func(characterID uint32) (string, error)
+
+// Watcher polls characters online status, location and ship and emits events when they change
+type Watcher struct {
+	esi    Client
+	tokens TokenSource
+}
+
+type watchState struct {
+	online   *OnlineStatus
+	location *Location
+	ship     *Ship
+}
+
+// CreateWatcher creates a new instance of the Watcher
+func CreateWatcher(client *Client, tokens TokenSource) *Watcher {
+	return &Watcher{
+		esi:    *client,
+		tokens: tokens,
+	}
+}
+
+// Watch starts polling each character until the context is cancelled, the returned channel is closed once every poller stops.
+// Each endpoint is only polled again after its cached response expires.
+func (watcher *Watcher) Watch(ctx context.Context, characterIDs []uint32) <-chan WatchEvent {
+	events := make(chan WatchEvent)
+
+	var wait sync.WaitGroup
+	for _, characterID := range characterIDs {
+		wait.Add(1)
+		go func(characterID uint32) {
+			defer wait.Done()
+			watcher.poll(ctx, characterID, events)
+		}(characterID)
+	}
+
+	go func() {
+		wait.Wait()
+		close(events)
+	}()
+
+	return events
+}
+
+func (watcher *Watcher) poll(ctx context.Context, characterID uint32, events chan<- WatchEvent) {
+	var state watchState
+	var nextOnline, nextLocation, nextShip time.Time
+
+	emit := func(event WatchEvent) bool {
+		event.CharacterID = characterID
+		event.Time = time.Now()
+
+		select {
+		case events <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for {
+		next := nextOnline
+		if state.online != nil && state.online.Online {
+			next = earliest(next, nextLocation, nextShip)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+		}
+
+		token, err := watcher.tokens(characterID)
+		if err != nil {
+			if !emit(WatchEvent{Type: EventError, Err: err}) {
+				return
+			}
+
+			retry := time.Now().Add(minimumPollInterval)
+			nextOnline, nextLocation, nextShip = retry, retry, retry
+			continue
+		}
+
+		now := time.Now()
+		if !now.Before(nextOnline) {
+			online, expires, err := watcher.esi.getCharacterOnline(characterID, token)
+			nextOnline = nextPoll(expires, err)
+
+			if err != nil {
+				if !emit(WatchEvent{Type: EventError, Err: err}) {
+					return
+				}
+			} else {
+				if state.online != nil && state.online.Online != online.Online {
+					event := WatchEvent{Type: EventLoggedOut}
+					if online.Online {
+						event.Type = EventLoggedIn
+					}
+
+					if !emit(event) {
+						return
+					}
+				}
+
+				// Location and ship don't change while offline, so pick them back up straight away on login
+				if online.Online && (state.online == nil || !state.online.Online) {
+					nextLocation, nextShip = now, now
+				}
+
+				state.online = &online
+			}
+		}
+
+		if state.online == nil || !state.online.Online {
+			continue
+		}
+
+		if !now.Before(nextLocation) {
+			location, expires, err := watcher.esi.getCharacterLocation(characterID, token)
+			nextLocation = nextPoll(expires, err)
+
+			if err != nil {
+				if !emit(WatchEvent{Type: EventError, Err: err}) {
+					return
+				}
+			} else {
+				if state.location != nil {
+					for _, event := range locationEvents(*state.location, location) {
+						if !emit(event) {
+							return
+						}
+					}
+				}
+
+				state.location = &location
+			}
+		}
+
+		if !now.Before(nextShip) {
+			ship, expires, err := watcher.esi.getCharacterShip(characterID, token)
+			nextShip = nextPoll(expires, err)
+
+			if err != nil {
+				if !emit(WatchEvent{Type: EventError, Err: err}) {
+					return
+				}
+			} else {
+				if state.ship != nil && state.ship.ShipItemID != ship.ShipItemID {
+					event := WatchEvent{Type: EventShipChanged, Ship: ship, PreviousShip: *state.ship}
+					if !emit(event) {
+						return
+					}
+				}
+
+				state.ship = &ship
+			}
+		}
+	}
+}
+
+func locationEvents(previous Location, current Location) []WatchEvent {
+	var events []WatchEvent
+	base := WatchEvent{Location: current, PreviousLocation: previous}
+
+	wasDocked := previous.StationID != 0 || previous.StructureID != 0
+	isDocked := current.StationID != 0 || current.StructureID != 0
+	movedDock := previous.StationID != current.StationID || previous.StructureID != current.StructureID
+
+	if wasDocked && (!isDocked || movedDock) {
+		event := base
+		event.Type = EventUndocked
+		events = append(events, event)
+	}
+
+	if previous.SolarSystemID != current.SolarSystemID {
+		event := base
+		event.Type = EventJumped
+		events = append(events, event)
+	}
+
+	if isDocked && (!wasDocked || movedDock) {
+		event := base
+		event.Type = EventDocked
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func nextPoll(expires time.Time, err error) time.Time {
+	fallback := time.Now().Add(minimumPollInterval)
+	if err != nil || expires.IsZero() || expires.Before(time.Now()) {
+		return fallback
+	}
+
+	return expires
+}
+
+func earliest(times ...time.Time) time.Time {
+	first := times[0]
+	for _, value := range times[1:] {
+		if value.Before(first) {
+			first = value
+		}
+	}
+
+	return first
+}